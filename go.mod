@@ -1,12 +1,15 @@
 module github.com/seekerror/stdlib
 
-go 1.20
+go 1.23
+
+require (
+	github.com/stretchr/testify v1.7.2
+	golang.org/x/exp v0.0.0-20220602145555-4a0574d9293f
+)
 
 require (
 	github.com/davecgh/go-spew v1.1.0 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
 	github.com/stretchr/objx v0.1.0 // indirect
-	github.com/stretchr/testify v1.7.2 // indirect
-	golang.org/x/exp v0.0.0-20220602145555-4a0574d9293f // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
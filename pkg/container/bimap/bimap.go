@@ -0,0 +1,111 @@
+// Package bimap contains a generic implementation of a bidirectional map.
+package bimap
+
+import (
+	"errors"
+	"fmt"
+	"github.com/seekerror/stdlib/pkg/container"
+	"github.com/seekerror/stdlib/pkg/lang"
+)
+
+// ErrConflict is returned if a value is already mapped to a different key.
+var ErrConflict = errors.New("value already mapped")
+
+// BiMap is a one-to-one mapping between keys and values, backed by two hash maps. Each value is
+// mapped to at most one key. Not thread-safe.
+type BiMap[K, V comparable] struct {
+	fwd     map[K]V
+	inv     map[V]K
+	inverse *BiMap[V, K]
+}
+
+// New returns an empty bidirectional map. Not thread-safe.
+func New[K, V comparable]() *BiMap[K, V] {
+	fwd := map[K]V{}
+	inv := map[V]K{}
+
+	ret := &BiMap[K, V]{fwd: fwd, inv: inv}
+	ret.inverse = &BiMap[V, K]{fwd: inv, inv: fwd, inverse: ret}
+	return ret
+}
+
+// Inverse returns the value-to-key view of the map. The view shares the underlying mappings, so
+// changes to either are visible in both.
+func (b *BiMap[K, V]) Inverse() *BiMap[V, K] {
+	return b.inverse
+}
+
+// List returns an iterator over all elements in an unspecified order. The elements are captured
+// when List is called.
+func (b *BiMap[K, V]) List() lang.Iterator[container.KV[K, V]] {
+	var ret []container.KV[K, V]
+	for k, v := range b.fwd {
+		ret = append(ret, container.KV[K, V]{K: k, V: v})
+	}
+	return &iterator[K, V]{list: ret}
+}
+
+func (b *BiMap[K, V]) IsEmpty() bool {
+	return len(b.fwd) == 0
+}
+
+// Len returns the number of elements.
+func (b *BiMap[K, V]) Len() int {
+	return len(b.fwd)
+}
+
+func (b *BiMap[K, V]) Find(k K) (V, bool) {
+	v, ok := b.fwd[k]
+	return v, ok
+}
+
+// Insert sets the value of the key. Returns prior value, if present. If the value is already mapped
+// to a different key, that mapping is removed. Use TryInsert to reject such conflicts instead.
+func (b *BiMap[K, V]) Insert(k K, v V) (V, bool) {
+	if k2, ok := b.inv[v]; ok && k2 != k {
+		delete(b.fwd, k2)
+	}
+	old, ok := b.fwd[k]
+	if ok {
+		delete(b.inv, old)
+	}
+	b.fwd[k] = v
+	b.inv[v] = k
+	return old, ok
+}
+
+// TryInsert sets the value of the key, unless the value is already mapped to a different key. In
+// that case, the map is unchanged and an error wrapping ErrConflict is returned.
+func (b *BiMap[K, V]) TryInsert(k K, v V) error {
+	if k2, ok := b.inv[v]; ok && k2 != k {
+		return fmt.Errorf("%w: %v is mapped to %v", ErrConflict, v, k2)
+	}
+	b.Insert(k, v)
+	return nil
+}
+
+func (b *BiMap[K, V]) Remove(k K) (V, bool) {
+	v, ok := b.fwd[k]
+	if ok {
+		delete(b.fwd, k)
+		delete(b.inv, v)
+	}
+	return v, ok
+}
+
+func (b *BiMap[K, V]) String() string {
+	return lang.Sprint(b.List())
+}
+
+type iterator[K, V comparable] struct {
+	list []container.KV[K, V]
+}
+
+func (it *iterator[K, V]) Next() (container.KV[K, V], bool) {
+	if len(it.list) == 0 {
+		return container.KV[K, V]{}, false
+	}
+	ret := it.list[0]
+	it.list = it.list[1:]
+	return ret, true
+}
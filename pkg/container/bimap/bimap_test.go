@@ -0,0 +1,62 @@
+package bimap_test
+
+import (
+	"github.com/seekerror/stdlib/pkg/container/bimap"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestBiMap(t *testing.T) {
+	b := bimap.New[int, string]()
+	inv := b.Inverse()
+	assert.True(t, b.IsEmpty())
+	assert.True(t, inv.IsEmpty())
+
+	// (1) Insert is visible in both directions
+
+	b.Insert(1, "a")
+	inv.Insert("b", 2)
+
+	v, ok := b.Find(2)
+	assert.True(t, ok)
+	assert.Equal(t, "b", v)
+
+	k, ok := inv.Find("a")
+	assert.True(t, ok)
+	assert.Equal(t, 1, k)
+
+	// (2) Update removes the stale inverse mapping
+
+	old, ok := b.Insert(1, "c")
+	assert.True(t, ok)
+	assert.Equal(t, "a", old)
+
+	_, ok = inv.Find("a")
+	assert.False(t, ok)
+	assert.Equal(t, 2, b.Len())
+	assert.Equal(t, 2, inv.Len())
+
+	// (3) Conflicts are rejected by TryInsert and forced by Insert
+
+	err := b.TryInsert(3, "c")
+	assert.ErrorIs(t, err, bimap.ErrConflict)
+	_, ok = b.Find(3)
+	assert.False(t, ok)
+
+	assert.NoError(t, b.TryInsert(1, "c"))
+
+	b.Insert(3, "c")
+	_, ok = b.Find(1)
+	assert.False(t, ok)
+	k, _ = inv.Find("c")
+	assert.Equal(t, 3, k)
+
+	// (4) Remove from the inverse
+
+	k, ok = inv.Remove("b")
+	assert.True(t, ok)
+	assert.Equal(t, 2, k)
+	_, ok = b.Find(2)
+	assert.False(t, ok)
+	assert.Equal(t, 1, b.Len())
+}
@@ -0,0 +1,158 @@
+// Package dicttest provides a conformance test suite for container.Dictionary implementations.
+package dicttest
+
+import (
+	"fmt"
+	"github.com/seekerror/stdlib/pkg/container"
+	"github.com/seekerror/stdlib/pkg/lang"
+	"github.com/seekerror/stdlib/pkg/util/quickx"
+	"math/rand"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// Config holds the parameters of the conformance suite.
+type Config[K comparable, V any] struct {
+	// Keys generates keys. Keys should collide often at small sizes to exercise updates and removals.
+	Keys quickx.Gen[K]
+	// Values generates values.
+	Values quickx.Gen[V]
+	// Compare is the key ordering. Optional. If present, List must return keys in increasing order.
+	Compare lang.CompareFn[K]
+	// Check configures the number and size of random operation sequences.
+	Check quickx.Config
+}
+
+// Run drives dictionaries created by the factory through random sequences of Insert, Remove and
+// Find operations and compares the results, and the final List, against a Go map. A failing
+// sequence is shrunk to a minimal one.
+func Run[K comparable, V any](t *testing.T, factory func() container.Dictionary[K, V], cfg Config[K, V]) {
+	t.Helper()
+
+	ops := quickx.Slices(quickx.Gen[op[K, V]]{
+		Generate: func(r *rand.Rand, size int) op[K, V] {
+			return op[K, V]{
+				kind: opKind(r.Intn(3)),
+				k:    cfg.Keys.Generate(r, size),
+				v:    cfg.Values.Generate(r, size),
+			}
+		},
+	})
+
+	if cfg.Check.Seed == 0 {
+		cfg.Check.Seed = time.Now().UnixNano()
+	}
+	list, _, ok := quickx.Falsify(cfg.Check, ops, func(list []op[K, V]) bool {
+		return verify(factory(), cfg.Compare, list) == ""
+	})
+	if ok {
+		t.Errorf("dictionary failed for %v: %v (seed: %v)", list, verify(factory(), cfg.Compare, list), cfg.Check.Seed)
+	}
+}
+
+type opKind int
+
+const (
+	insert opKind = iota
+	remove
+	find
+)
+
+type op[K, V any] struct {
+	kind opKind
+	k    K
+	v    V
+}
+
+func (o op[K, V]) String() string {
+	switch o.kind {
+	case insert:
+		return fmt.Sprintf("insert(%v, %v)", o.k, o.v)
+	case remove:
+		return fmt.Sprintf("remove(%v)", o.k)
+	default:
+		return fmt.Sprintf("find(%v)", o.k)
+	}
+}
+
+// verify applies the operations to the dictionary and a model map. Returns a description of the
+// first discrepancy, if any.
+func verify[K comparable, V any](dict container.Dictionary[K, V], cmp lang.CompareFn[K], ops []op[K, V]) string {
+	model := map[K]V{}
+
+	for i, o := range ops {
+		expected, expectedOK := model[o.k]
+
+		var actual V
+		var actualOK bool
+		switch o.kind {
+		case insert:
+			actual, actualOK = dict.Insert(o.k, o.v)
+			model[o.k] = o.v
+		case remove:
+			actual, actualOK = dict.Remove(o.k)
+			delete(model, o.k)
+		default:
+			actual, actualOK = dict.Find(o.k)
+		}
+
+		if actualOK != expectedOK || actualOK && !reflect.DeepEqual(actual, expected) {
+			return fmt.Sprintf("op %v: %v returned (%v, %v), expected (%v, %v)", i, o, actual, actualOK, expected, expectedOK)
+		}
+	}
+
+	list := lang.ToList(dict.List())
+	if len(list) != len(model) {
+		return fmt.Sprintf("list has %v elements, expected %v: %v", len(list), len(model), list)
+	}
+	for i, kv := range list {
+		v, ok := model[kv.K]
+		if !ok || !reflect.DeepEqual(kv.V, v) {
+			return fmt.Sprintf("list element %v is %v, expected %v: %v", i, kv, v, list)
+		}
+		if cmp != nil && i > 0 && cmp(list[i-1].K, kv.K) >= 0 {
+			return fmt.Sprintf("list elements %v and %v not in order: %v", i-1, i, list)
+		}
+	}
+	if c, ok := dict.(container.Container[container.KV[K, V]]); ok && c.IsEmpty() != (len(model) == 0) {
+		return fmt.Sprintf("IsEmpty is %v with %v elements", c.IsEmpty(), len(model))
+	}
+	return ""
+}
+
+// Benchmark measures Insert, Find and Remove of the given keys for dictionaries created by the
+// factory. Each operation is measured cycling through the keys.
+func Benchmark[K comparable, V any](b *testing.B, factory func() container.Dictionary[K, V], keys []K, value V) {
+	b.Run("Insert", func(b *testing.B) {
+		dict := factory()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			dict.Insert(keys[i%len(keys)], value)
+		}
+	})
+	b.Run("Find", func(b *testing.B) {
+		dict := factory()
+		for _, k := range keys {
+			dict.Insert(k, value)
+		}
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			dict.Find(keys[i%len(keys)])
+		}
+	})
+	b.Run("Remove", func(b *testing.B) {
+		dict := factory()
+		for i := 0; i < b.N; i++ {
+			k := keys[i%len(keys)]
+			if i%len(keys) == 0 {
+				b.StopTimer()
+				for _, k := range keys {
+					dict.Insert(k, value)
+				}
+				b.StartTimer()
+			}
+			dict.Remove(k)
+		}
+	})
+}
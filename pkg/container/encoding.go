@@ -0,0 +1,187 @@
+package container
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/seekerror/stdlib/pkg/lang"
+	"golang.org/x/exp/constraints"
+	"io"
+	"math"
+)
+
+// Codec is a binary encoding of T values. Encodings need not be self-delimiting.
+type Codec[T any] interface {
+	Encode(t T) ([]byte, error)
+	Decode(data []byte) (T, error)
+}
+
+// StringCodec returns a codec that encodes strings as their raw bytes.
+func StringCodec() Codec[string] {
+	return stringCodec{}
+}
+
+type stringCodec struct{}
+
+func (stringCodec) Encode(t string) ([]byte, error) {
+	return []byte(t), nil
+}
+
+func (stringCodec) Decode(data []byte) (string, error) {
+	return string(data), nil
+}
+
+// VarintCodec returns a codec that encodes signed integers as varints.
+func VarintCodec[T constraints.Signed]() Codec[T] {
+	return varintCodec[T]{}
+}
+
+type varintCodec[T constraints.Signed] struct{}
+
+func (varintCodec[T]) Encode(t T) ([]byte, error) {
+	return binary.AppendVarint(nil, int64(t)), nil
+}
+
+func (varintCodec[T]) Decode(data []byte) (T, error) {
+	v, n := binary.Varint(data)
+	if n <= 0 || n != len(data) {
+		return 0, fmt.Errorf("invalid varint: %x", data)
+	}
+	return T(v), nil
+}
+
+// UvarintCodec returns a codec that encodes unsigned integers as uvarints.
+func UvarintCodec[T constraints.Unsigned]() Codec[T] {
+	return uvarintCodec[T]{}
+}
+
+type uvarintCodec[T constraints.Unsigned] struct{}
+
+func (uvarintCodec[T]) Encode(t T) ([]byte, error) {
+	return binary.AppendUvarint(nil, uint64(t)), nil
+}
+
+func (uvarintCodec[T]) Decode(data []byte) (T, error) {
+	v, n := binary.Uvarint(data)
+	if n <= 0 || n != len(data) {
+		return 0, fmt.Errorf("invalid uvarint: %x", data)
+	}
+	return T(v), nil
+}
+
+// JSONCodec returns a codec that encodes values as JSON. Convenience function.
+func JSONCodec[T any]() Codec[T] {
+	return jsonCodec[T]{}
+}
+
+type jsonCodec[T any] struct{}
+
+func (jsonCodec[T]) Encode(t T) ([]byte, error) {
+	return json.Marshal(t)
+}
+
+func (jsonCodec[T]) Decode(data []byte) (T, error) {
+	var t T
+	err := json.Unmarshal(data, &t)
+	return t, err
+}
+
+// MarshalJSON encodes the dictionary elements as a JSON array of {"K": k, "V": v} objects in
+// List order. Keys are not required to be strings.
+func MarshalJSON[K, V any](dict Dictionary[K, V]) ([]byte, error) {
+	list := lang.ToList(dict.List())
+	if list == nil {
+		list = []KV[K, V]{}
+	}
+	return json.Marshal(list)
+}
+
+// UnmarshalJSON decodes a JSON array of {"K": k, "V": v} objects.
+func UnmarshalJSON[K, V any](data []byte) ([]KV[K, V], error) {
+	var list []KV[K, V]
+	if err := json.Unmarshal(data, &list); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// WriteBinary writes the elements in a compact binary form: a uvarint count followed by each key
+// and value encoding, prefixed by its uvarint length.
+func WriteBinary[K, V any](w io.Writer, it lang.Iterator[KV[K, V]], kc Codec[K], vc Codec[V]) error {
+	list := lang.ToList(it)
+
+	buf := binary.AppendUvarint(nil, uint64(len(list)))
+	for _, kv := range list {
+		k, err := kc.Encode(kv.K)
+		if err != nil {
+			return fmt.Errorf("failed to encode key %v: %w", kv.K, err)
+		}
+		v, err := vc.Encode(kv.V)
+		if err != nil {
+			return fmt.Errorf("failed to encode value %v: %w", kv.V, err)
+		}
+		buf = binary.AppendUvarint(buf, uint64(len(k)))
+		buf = append(buf, k...)
+		buf = binary.AppendUvarint(buf, uint64(len(v)))
+		buf = append(buf, v...)
+	}
+	_, err := w.Write(buf)
+	return err
+}
+
+// ReadBinary reads elements written by WriteBinary.
+func ReadBinary[K, V any](r io.Reader, kc Codec[K], vc Codec[V]) ([]KV[K, V], error) {
+	br, ok := r.(io.ByteReader)
+	if !ok {
+		b := bufio.NewReader(r)
+		r, br = b, b
+	}
+
+	n, err := binary.ReadUvarint(br)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read count: %w", err)
+	}
+
+	var ret []KV[K, V]
+	for i := uint64(0); i < n; i++ {
+		data, err := readBlock(r, br)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read key %v: %w", i, err)
+		}
+		k, err := kc.Decode(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode key %v: %w", i, err)
+		}
+		data, err = readBlock(r, br)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read value %v: %w", i, err)
+		}
+		v, err := vc.Decode(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode value %v: %w", i, err)
+		}
+		ret = append(ret, KV[K, V]{K: k, V: v})
+	}
+	return ret, nil
+}
+
+// readBlock reads a uvarint length-prefixed block.
+func readBlock(r io.Reader, br io.ByteReader) ([]byte, error) {
+	n, err := binary.ReadUvarint(br)
+	if err != nil {
+		return nil, err
+	}
+	if n > math.MaxInt32 {
+		return nil, fmt.Errorf("invalid length: %v", n)
+	}
+	ret := make([]byte, n)
+	if _, err := io.ReadFull(r, ret); err != nil {
+		if errors.Is(err, io.EOF) {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	return ret, nil
+}
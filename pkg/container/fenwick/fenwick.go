@@ -0,0 +1,86 @@
+// Package fenwick contains a generic implementation of a Fenwick tree (binary indexed tree).
+package fenwick
+
+import (
+	"fmt"
+	"golang.org/x/exp/constraints"
+)
+
+// Tree is a Fenwick tree over n indexed values, supporting point update and prefix query in
+// O(log n). The combine function must be associative and commutative with the given identity,
+// such as addition with 0 or max with the smallest value. Not thread-safe.
+type Tree[T any] struct {
+	tree     []T // 1-indexed: tree[i] aggregates the values in (i - lowbit(i), i]
+	identity T
+	combine  func(a, b T) T
+	inverse  func(a, b T) T
+}
+
+// New returns a Fenwick tree of n identity values. Range queries are not supported without an
+// inverse, i.e., only prefix queries. Not thread-safe.
+func New[T any](n int, identity T, combine func(a, b T) T) *Tree[T] {
+	return NewT(n, identity, combine, nil)
+}
+
+// NewT returns a Fenwick tree of n identity values. If inverse is not nil, it must undo combine,
+// i.e., inverse(combine(a, b), b) == a, such as subtraction for addition. It enables range
+// queries. Not thread-safe.
+func NewT[T any](n int, identity T, combine, inverse func(a, b T) T) *Tree[T] {
+	tree := make([]T, n+1)
+	for i := range tree {
+		tree[i] = identity
+	}
+	return &Tree[T]{
+		tree:     tree,
+		identity: identity,
+		combine:  combine,
+		inverse:  inverse,
+	}
+}
+
+// NewSum returns a Fenwick tree of n zero values for range sums. Convenience function.
+func NewSum[T constraints.Integer | constraints.Float](n int) *Tree[T] {
+	return NewT[T](n, 0, func(a, b T) T {
+		return a + b
+	}, func(a, b T) T {
+		return a - b
+	})
+}
+
+// Len returns the number of indexed values.
+func (t *Tree[T]) Len() int {
+	return len(t.tree) - 1
+}
+
+// Update combines the value at index i with v, i.e., adds v for sums.
+func (t *Tree[T]) Update(i int, v T) {
+	t.check(i, i+1)
+	for i++; i < len(t.tree); i += i & -i {
+		t.tree[i] = t.combine(t.tree[i], v)
+	}
+}
+
+// Prefix returns the combined values at indices [0;n).
+func (t *Tree[T]) Prefix(n int) T {
+	t.check(0, n)
+	ret := t.identity
+	for ; n > 0; n -= n & -n {
+		ret = t.combine(ret, t.tree[n])
+	}
+	return ret
+}
+
+// Range returns the combined values at indices [from;to). Panics if the tree has no inverse.
+func (t *Tree[T]) Range(from, to int) T {
+	if t.inverse == nil {
+		panic("fenwick: range query without inverse")
+	}
+	t.check(from, to)
+	return t.inverse(t.Prefix(to), t.Prefix(from))
+}
+
+func (t *Tree[T]) check(from, to int) {
+	if from < 0 || to < from || to > t.Len() {
+		panic(fmt.Sprintf("fenwick: range [%v;%v) out of bounds [0;%v)", from, to, t.Len()))
+	}
+}
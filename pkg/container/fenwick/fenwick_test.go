@@ -0,0 +1,46 @@
+package fenwick_test
+
+import (
+	"github.com/seekerror/stdlib/pkg/container/fenwick"
+	"github.com/seekerror/stdlib/pkg/util/mathx"
+	"github.com/stretchr/testify/assert"
+	"math/rand"
+	"testing"
+)
+
+func TestTree(t *testing.T) {
+	const N = 100
+
+	sum := fenwick.NewSum[int](N)
+	max := fenwick.New[int](N, 0, func(a, b int) int {
+		return mathx.Max(a, b)
+	})
+	values := make([]int, N)
+	maxes := make([]int, N)
+
+	for k := 0; k < 1000; k++ {
+		i, v := rand.Intn(N), rand.Intn(100)
+		sum.Update(i, v)
+		max.Update(i, v)
+		values[i] += v
+		maxes[i] = mathx.Max(maxes[i], v)
+
+		from := rand.Intn(N + 1)
+		to := from + rand.Intn(N+1-from)
+
+		expected := 0
+		for _, e := range values[from:to] {
+			expected += e
+		}
+		assert.Equal(t, expected, sum.Range(from, to))
+
+		expected = 0
+		for _, e := range maxes[:to] {
+			expected = mathx.Max(expected, e)
+		}
+		assert.Equal(t, expected, max.Prefix(to))
+	}
+	assert.Panics(t, func() {
+		max.Range(0, 1)
+	})
+}
@@ -0,0 +1,50 @@
+package container
+
+import (
+	"fmt"
+	"github.com/seekerror/stdlib/pkg/lang"
+)
+
+// GroupBy materializes all iterator values into lists by key in the given dictionary, such as a
+// search tree for ordered keys. Returns the dictionary for convenience.
+func GroupBy[T, K any, D Dictionary[K, []T]](it lang.Iterator[T], key func(t T) K, dict D) D {
+	for {
+		t, ok := it.Next()
+		if !ok {
+			return dict
+		}
+		k := key(t)
+		list, _ := dict.Find(k)
+		dict.Insert(k, append(list, t))
+	}
+}
+
+// CountBy counts all iterator values by key in the given dictionary. Returns the dictionary for
+// convenience.
+func CountBy[T, K any, D Dictionary[K, int]](it lang.Iterator[T], key func(t T) K, dict D) D {
+	for {
+		t, ok := it.Next()
+		if !ok {
+			return dict
+		}
+		k := key(t)
+		n, _ := dict.Find(k)
+		dict.Insert(k, n+1)
+	}
+}
+
+// IndexBy materializes all iterator values by key in the given dictionary. Returns an error on the
+// first duplicate key.
+func IndexBy[T, K any, D Dictionary[K, T]](it lang.Iterator[T], key func(t T) K, dict D) (D, error) {
+	for {
+		t, ok := it.Next()
+		if !ok {
+			return dict, nil
+		}
+		k := key(t)
+		if _, ok := dict.Find(k); ok {
+			return dict, fmt.Errorf("duplicate key: %v", k)
+		}
+		dict.Insert(k, t)
+	}
+}
@@ -0,0 +1,30 @@
+package container_test
+
+import (
+	"github.com/seekerror/stdlib/pkg/container"
+	"github.com/seekerror/stdlib/pkg/container/redgreen"
+	"github.com/seekerror/stdlib/pkg/lang"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestGroup(t *testing.T) {
+	words := func() lang.Iterator[string] {
+		return lang.FromSlice([]string{"cherry", "banana", "apple", "blueberry", "avocado"})
+	}
+	first := func(s string) string {
+		return s[:1]
+	}
+
+	groups := container.GroupBy(words(), first, redgreen.New[string, []string]())
+	assert.Equal(t, "[a:[apple avocado], b:[banana blueberry], c:[cherry]]", groups.String())
+
+	counts := container.CountBy(words(), first, redgreen.New[string, int]())
+	assert.Equal(t, "[a:2, b:2, c:1]", counts.String())
+
+	_, err := container.IndexBy(words(), first, redgreen.New[string, string]())
+	assert.Error(t, err)
+	index, err := container.IndexBy(words(), func(s string) string { return s[1:3] }, redgreen.New[string, string]())
+	assert.NoError(t, err)
+	assert.Equal(t, "[an:banana, he:cherry, lu:blueberry, pp:apple, vo:avocado]", index.String())
+}
@@ -0,0 +1,194 @@
+// Package linkedhash contains a generic implementation of an insertion-ordered hash map.
+package linkedhash
+
+import (
+	"github.com/seekerror/stdlib/pkg/container"
+	"github.com/seekerror/stdlib/pkg/lang"
+)
+
+// node is the internal doubly-linked list node.
+type node[K comparable, V any] struct {
+	prev, next *node[K, V]
+
+	key   K
+	value V
+	seq   uint64 // position in link order, used to bound iteration
+}
+
+// Map is a hash map that lists its elements in insertion order, or optionally in access order. Find,
+// Insert and Remove are O(1). Not thread-safe.
+type Map[K comparable, V any] struct {
+	m      map[K]*node[K, V]
+	nil    *node[K, V] // sentinel: nil.next is the first node and nil.prev is the last node
+	seq    uint64      // next node sequence number
+	access bool
+}
+
+// New returns a hash map that lists its elements in insertion order. Re-inserting an existing key
+// does not change its position. Not thread-safe.
+func New[K comparable, V any]() *Map[K, V] {
+	return newMap[K, V](false)
+}
+
+// NewAccessOrdered returns a hash map that lists its elements in access order, from least recently
+// to most recently accessed. Find and Insert move the key to the back. Not thread-safe.
+func NewAccessOrdered[K comparable, V any]() *Map[K, V] {
+	return newMap[K, V](true)
+}
+
+func newMap[K comparable, V any](access bool) *Map[K, V] {
+	n := &node[K, V]{}
+	n.prev = n
+	n.next = n
+	return &Map[K, V]{
+		m:      map[K]*node[K, V]{},
+		nil:    n,
+		access: access,
+	}
+}
+
+// List returns the elements in order. The iterator lists only the elements present when List is
+// called, in their order at that time. It is safe to Find, Insert or Remove any key during
+// iteration: removed elements not yet listed are skipped and inserted elements are not listed.
+// For an access-ordered map, where accesses reorder the elements, List takes an O(n) snapshot.
+func (m *Map[K, V]) List() lang.Iterator[container.KV[K, V]] {
+	if m.access {
+		var nodes []*node[K, V]
+		for n := m.nil.next; n != m.nil; n = n.next {
+			nodes = append(nodes, n)
+		}
+		return &snapshot[K, V]{nodes: nodes}
+	}
+	return &iterator[K, V]{m: m, next: m.nil.next, end: m.seq}
+}
+
+func (m *Map[K, V]) IsEmpty() bool {
+	return len(m.m) == 0
+}
+
+// Len returns the number of elements.
+func (m *Map[K, V]) Len() int {
+	return len(m.m)
+}
+
+func (m *Map[K, V]) Find(key K) (V, bool) {
+	if n, ok := m.m[key]; ok {
+		if m.access {
+			m.moveToBack(n)
+		}
+		return n.value, true
+	}
+	var v V
+	return v, false
+}
+
+func (m *Map[K, V]) Insert(key K, value V) (V, bool) {
+	if n, ok := m.m[key]; ok {
+		ret := n.value
+		n.value = value
+		if m.access {
+			m.moveToBack(n)
+		}
+		return ret, true
+	}
+
+	n := &node[K, V]{key: key, value: value}
+	m.m[key] = n
+	m.pushBack(n)
+
+	var v V
+	return v, false
+}
+
+func (m *Map[K, V]) Remove(key K) (V, bool) {
+	if n, ok := m.m[key]; ok {
+		delete(m.m, key)
+		m.unlink(n)
+		return n.value, true
+	}
+	var v V
+	return v, false
+}
+
+// Front returns the first element, i.e., the oldest inserted or least recently accessed. Does not
+// count as an access.
+func (m *Map[K, V]) Front() (container.KV[K, V], bool) {
+	if n := m.nil.next; n != m.nil {
+		return container.KV[K, V]{K: n.key, V: n.value}, true
+	}
+	return container.KV[K, V]{}, false
+}
+
+// Back returns the last element, i.e., the newest inserted or most recently accessed. Does not
+// count as an access.
+func (m *Map[K, V]) Back() (container.KV[K, V], bool) {
+	if n := m.nil.prev; n != m.nil {
+		return container.KV[K, V]{K: n.key, V: n.value}, true
+	}
+	return container.KV[K, V]{}, false
+}
+
+func (m *Map[K, V]) String() string {
+	return lang.Sprint(m.List())
+}
+
+// pushBack links n in as the last node.
+func (m *Map[K, V]) pushBack(n *node[K, V]) {
+	n.seq = m.seq
+	m.seq++
+	n.prev = m.nil.prev
+	n.next = m.nil
+	n.prev.next = n
+	m.nil.prev = n
+}
+
+// unlink removes n from the list. It keeps n.next intact, so that iteration can continue past a
+// removed node.
+func (m *Map[K, V]) unlink(n *node[K, V]) {
+	n.prev.next = n.next
+	n.next.prev = n.prev
+	n.prev = nil
+}
+
+func (m *Map[K, V]) moveToBack(n *node[K, V]) {
+	if m.nil.prev != n {
+		m.unlink(n)
+		m.pushBack(n)
+	}
+}
+
+type iterator[K comparable, V any] struct {
+	m    *Map[K, V]
+	next *node[K, V]
+	end  uint64 // nodes linked at or after end, i.e., inserted later, are not listed
+}
+
+func (it *iterator[K, V]) Next() (container.KV[K, V], bool) {
+	for it.next != it.m.nil && it.next.prev == nil {
+		it.next = it.next.next // skip removed nodes
+	}
+	if it.next == it.m.nil || it.next.seq >= it.end {
+		it.next = it.m.nil
+		return container.KV[K, V]{}, false
+	}
+	cur := it.next
+	it.next = cur.next
+
+	return container.KV[K, V]{K: cur.key, V: cur.value}, true
+}
+
+// snapshot iterates over a fixed list of nodes, which is unaffected by accesses.
+type snapshot[K comparable, V any] struct {
+	nodes []*node[K, V]
+}
+
+func (it *snapshot[K, V]) Next() (container.KV[K, V], bool) {
+	for len(it.nodes) > 0 {
+		cur := it.nodes[0]
+		it.nodes = it.nodes[1:]
+		if cur.prev != nil { // skip removed nodes
+			return container.KV[K, V]{K: cur.key, V: cur.value}, true
+		}
+	}
+	return container.KV[K, V]{}, false
+}
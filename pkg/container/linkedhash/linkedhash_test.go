@@ -0,0 +1,160 @@
+package linkedhash_test
+
+import (
+	"github.com/seekerror/stdlib/pkg/container"
+	"github.com/seekerror/stdlib/pkg/container/dicttest"
+	"github.com/seekerror/stdlib/pkg/container/linkedhash"
+	"github.com/seekerror/stdlib/pkg/lang"
+	"github.com/seekerror/stdlib/pkg/util/quickx"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func keys[K comparable, V any](m *linkedhash.Map[K, V]) []K {
+	return lang.ToList(lang.Map(m.List(), func(kv container.KV[K, V]) K {
+		return kv.K
+	}))
+}
+
+func TestMap(t *testing.T) {
+	// (1) Empty map
+
+	m := linkedhash.New[string, int]()
+	assert.True(t, m.IsEmpty())
+	assert.Equal(t, 0, len(keys(m)))
+
+	_, ok := m.Find("a")
+	assert.False(t, ok)
+
+	// (2) Insertion order is retained, also on update
+
+	for i, k := range []string{"c", "a", "d", "b"} {
+		_, ok := m.Insert(k, i)
+		assert.False(t, ok)
+	}
+	assert.Equal(t, []string{"c", "a", "d", "b"}, keys(m))
+
+	v, ok := m.Insert("a", 10)
+	assert.True(t, ok)
+	assert.Equal(t, 1, v)
+	assert.Equal(t, []string{"c", "a", "d", "b"}, keys(m))
+
+	v, ok = m.Find("c")
+	assert.True(t, ok)
+	assert.Equal(t, 0, v)
+	assert.Equal(t, []string{"c", "a", "d", "b"}, keys(m))
+
+	// (3) Remove and re-insert moves to back
+
+	v, ok = m.Remove("a")
+	assert.True(t, ok)
+	assert.Equal(t, 10, v)
+	assert.Equal(t, []string{"c", "d", "b"}, keys(m))
+
+	m.Insert("a", 20)
+	assert.Equal(t, []string{"c", "d", "b", "a"}, keys(m))
+	assert.Equal(t, 4, m.Len())
+
+	front, _ := m.Front()
+	back, _ := m.Back()
+	assert.Equal(t, "c", front.K)
+	assert.Equal(t, "a", back.K)
+}
+
+func TestMapAccessOrdered(t *testing.T) {
+	m := linkedhash.NewAccessOrdered[int, int]()
+	for i := 0; i < 5; i++ {
+		m.Insert(i, i)
+	}
+	assert.Equal(t, []int{0, 1, 2, 3, 4}, keys(m))
+
+	m.Find(1)
+	m.Insert(3, 30)
+	m.Find(7)
+	assert.Equal(t, []int{0, 2, 4, 1, 3}, keys(m))
+}
+
+func TestMapRemoveWhileIterating(t *testing.T) {
+	m := linkedhash.New[int, int]()
+	for i := 0; i < 5; i++ {
+		m.Insert(i, i)
+	}
+
+	var seen []int
+	it := m.List()
+	for {
+		kv, ok := it.Next()
+		if !ok {
+			break
+		}
+		seen = append(seen, kv.K)
+		m.Remove(kv.K)
+		m.Remove(kv.K + 1)
+	}
+	assert.Equal(t, []int{0, 2, 4}, seen)
+	assert.True(t, m.IsEmpty())
+}
+
+func TestMapConformance(t *testing.T) {
+	for _, fn := range []func() *linkedhash.Map[int, string]{linkedhash.New[int, string], linkedhash.NewAccessOrdered[int, string]} {
+		dicttest.Run(t, func() container.Dictionary[int, string] {
+			return fn()
+		}, dicttest.Config[int, string]{
+			Keys:   quickx.Ints(-1000, 1000),
+			Values: quickx.Strings(),
+			Check:  quickx.Config{Count: 500, MaxSize: 200},
+		})
+	}
+}
+
+func TestMapAccessWhileIterating(t *testing.T) {
+	m := linkedhash.NewAccessOrdered[int, int]()
+	for i := 0; i < 5; i++ {
+		m.Insert(i, i)
+	}
+
+	var seen []int
+	it := m.List()
+	for {
+		kv, ok := it.Next()
+		if !ok {
+			break
+		}
+		seen = append(seen, kv.K)
+		if kv.K%2 == 0 {
+			m.Find(kv.K)
+		} else {
+			m.Insert(kv.K, 10*kv.V)
+		}
+		m.Insert(10+kv.K, kv.V) // not listed
+	}
+	assert.Equal(t, []int{0, 1, 2, 3, 4}, seen)
+	assert.Equal(t, []int{0, 10, 1, 11, 2, 12, 3, 13, 4, 14}, keys(m))
+}
+
+func TestMapAccessOtherWhileIterating(t *testing.T) {
+	m := linkedhash.NewAccessOrdered[int, int]()
+	for i := 0; i < 5; i++ {
+		m.Insert(i, i)
+	}
+
+	var seen []int
+	it := m.List()
+	for {
+		kv, ok := it.Next()
+		if !ok {
+			break
+		}
+		seen = append(seen, kv.K)
+		switch kv.K {
+		case 0:
+			m.Find(2) // moves an unvisited key
+			m.Insert(4, 40)
+		case 1:
+			m.Find(0) // moves a visited key
+			m.Remove(3)
+		}
+	}
+	assert.Equal(t, []int{0, 1, 2, 4}, seen)
+	assert.Equal(t, []int{1, 2, 4, 0}, keys(m))
+}
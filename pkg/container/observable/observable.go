@@ -0,0 +1,155 @@
+// Package observable contains a Dictionary decorator that notifies listeners of changes.
+package observable
+
+import (
+	"fmt"
+	"github.com/seekerror/stdlib/pkg/container"
+	"github.com/seekerror/stdlib/pkg/lang"
+)
+
+// EventType is the type of change.
+type EventType int
+
+const (
+	Inserted EventType = iota + 1
+	Updated
+	Removed
+)
+
+func (e EventType) String() string {
+	switch e {
+	case Inserted:
+		return "inserted"
+	case Updated:
+		return "updated"
+	case Removed:
+		return "removed"
+	default:
+		return fmt.Sprintf("unknown(%d)", int(e))
+	}
+}
+
+// Event is a change to a dictionary. V is the new value if inserted or updated and the removed
+// value if removed. Old is the prior value if updated.
+type Event[K, V any] struct {
+	Type EventType
+	K    K
+	V    V
+	Old  V
+}
+
+func (e Event[K, V]) String() string {
+	if e.Type == Updated {
+		return fmt.Sprintf("%v(%v:%v->%v)", e.Type, e.K, e.Old, e.V)
+	}
+	return fmt.Sprintf("%v(%v:%v)", e.Type, e.K, e.V)
+}
+
+// Listener receives a batch of one or more events in the order they occurred.
+type Listener[K, V any] func(events []Event[K, V])
+
+// Chan returns a listener that sends each event on the chan. Blocks until all events are sent.
+func Chan[K, V any](ch chan<- Event[K, V]) Listener[K, V] {
+	return func(events []Event[K, V]) {
+		for _, e := range events {
+			ch <- e
+		}
+	}
+}
+
+// Dictionary is a decorator that notifies listeners of changes to the underlying dictionary.
+// Listeners are called synchronously after each change, or after each batch. Changes made
+// directly to the underlying dictionary are not observed. Not thread-safe.
+type Dictionary[K, V any] struct {
+	dict      container.Dictionary[K, V]
+	listeners []subscription[K, V]
+	next      int
+
+	depth int // nesting depth of Batch calls
+	batch []Event[K, V]
+}
+
+type subscription[K, V any] struct {
+	id int
+	fn Listener[K, V]
+}
+
+// New returns an observable dictionary backed by the given dictionary. Not thread-safe.
+func New[K, V any](dict container.Dictionary[K, V]) *Dictionary[K, V] {
+	return &Dictionary[K, V]{dict: dict}
+}
+
+// Subscribe registers a listener. Returns a function to unsubscribe it.
+func (d *Dictionary[K, V]) Subscribe(fn Listener[K, V]) func() {
+	id := d.next
+	d.next++
+	d.listeners = append(d.listeners, subscription[K, V]{id: id, fn: fn})
+
+	return func() {
+		for i, s := range d.listeners {
+			if s.id == id {
+				d.listeners = append(d.listeners[:i:i], d.listeners[i+1:]...)
+				return
+			}
+		}
+	}
+}
+
+// Batch calls fn and delivers all events of changes made within it as a single batch when it
+// returns. Nested calls are part of the outermost batch.
+func (d *Dictionary[K, V]) Batch(fn func()) {
+	d.depth++
+	defer func() {
+		d.depth--
+		if d.depth == 0 {
+			batch := d.batch
+			d.batch = nil
+			d.notify(batch)
+		}
+	}()
+
+	fn()
+}
+
+func (d *Dictionary[K, V]) List() lang.Iterator[container.KV[K, V]] {
+	return d.dict.List()
+}
+
+func (d *Dictionary[K, V]) Find(k K) (V, bool) {
+	return d.dict.Find(k)
+}
+
+func (d *Dictionary[K, V]) Insert(k K, v V) (V, bool) {
+	old, ok := d.dict.Insert(k, v)
+	if ok {
+		d.emit(Event[K, V]{Type: Updated, K: k, V: v, Old: old})
+	} else {
+		d.emit(Event[K, V]{Type: Inserted, K: k, V: v})
+	}
+	return old, ok
+}
+
+func (d *Dictionary[K, V]) Remove(k K) (V, bool) {
+	old, ok := d.dict.Remove(k)
+	if ok {
+		d.emit(Event[K, V]{Type: Removed, K: k, V: old})
+	}
+	return old, ok
+}
+
+func (d *Dictionary[K, V]) emit(e Event[K, V]) {
+	if d.depth > 0 {
+		d.batch = append(d.batch, e)
+		return
+	}
+	d.notify([]Event[K, V]{e})
+}
+
+func (d *Dictionary[K, V]) notify(events []Event[K, V]) {
+	if len(events) == 0 {
+		return
+	}
+	for _, s := range d.listeners {
+		s.fn(events)
+	}
+}
@@ -0,0 +1,74 @@
+package observable_test
+
+import (
+	"github.com/seekerror/stdlib/pkg/container/observable"
+	"github.com/seekerror/stdlib/pkg/container/redgreen"
+	"github.com/seekerror/stdlib/pkg/util/chanx"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestDictionary(t *testing.T) {
+	d := observable.New[string, int](redgreen.New[string, int]())
+
+	var batches [][]observable.Event[string, int]
+	unsubscribe := d.Subscribe(func(events []observable.Event[string, int]) {
+		batches = append(batches, events)
+	})
+
+	// (1) Individual changes
+
+	d.Insert("a", 1)
+	d.Insert("a", 2)
+	d.Remove("a")
+	d.Remove("b")
+
+	assert.Equal(t, [][]observable.Event[string, int]{
+		{{Type: observable.Inserted, K: "a", V: 1}},
+		{{Type: observable.Updated, K: "a", V: 2, Old: 1}},
+		{{Type: observable.Removed, K: "a", V: 2}},
+	}, batches)
+
+	// (2) Batched changes
+
+	batches = nil
+	d.Batch(func() {
+		d.Insert("b", 1)
+		d.Batch(func() {
+			d.Insert("c", 2)
+		})
+		d.Remove("b")
+	})
+	d.Batch(func() {})
+
+	assert.Equal(t, [][]observable.Event[string, int]{
+		{
+			{Type: observable.Inserted, K: "b", V: 1},
+			{Type: observable.Inserted, K: "c", V: 2},
+			{Type: observable.Removed, K: "b", V: 1},
+		},
+	}, batches)
+
+	// (3) Unsubscribe
+
+	batches = nil
+	unsubscribe()
+	d.Insert("d", 3)
+	assert.Len(t, batches, 0)
+}
+
+func TestChan(t *testing.T) {
+	d := observable.New[string, int](redgreen.New[string, int]())
+
+	ch := make(chan observable.Event[string, int], 10)
+	d.Subscribe(observable.Chan(ch))
+
+	d.Insert("a", 1)
+	d.Insert("b", 2)
+	close(ch)
+
+	keys := chanx.ToList(chanx.Map(ch, func(e observable.Event[string, int]) string {
+		return e.K
+	}))
+	assert.Equal(t, []string{"a", "b"}, keys)
+}
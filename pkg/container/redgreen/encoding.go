@@ -0,0 +1,105 @@
+package redgreen
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"github.com/seekerror/stdlib/pkg/container"
+	"io"
+	"math/bits"
+)
+
+// MarshalJSON encodes the tree as a JSON array of {"K": k, "V": v} objects in key order.
+func (t *SearchTree[K, V]) MarshalJSON() ([]byte, error) {
+	return container.MarshalJSON[K, V](t)
+}
+
+// UnmarshalJSON replaces the content of the tree with the decoded elements in O(n). The keys must
+// be in strictly increasing order. The tree must have been created by New or NewT.
+func (t *SearchTree[K, V]) UnmarshalJSON(data []byte) error {
+	list, err := container.UnmarshalJSON[K, V](data)
+	if err != nil {
+		return err
+	}
+	return t.build(list)
+}
+
+// GobEncode encodes the tree as a gob list of elements in key order.
+func (t *SearchTree[K, V]) GobEncode() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(t.toList()); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// GobDecode replaces the content of the tree with the decoded elements in O(n). The tree must
+// have been created by New or NewT.
+func (t *SearchTree[K, V]) GobDecode(data []byte) error {
+	var list []container.KV[K, V]
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&list); err != nil {
+		return err
+	}
+	return t.build(list)
+}
+
+// WriteBinary writes the tree in a compact length-prefixed binary form using the given codecs.
+func (t *SearchTree[K, V]) WriteBinary(w io.Writer, kc container.Codec[K], vc container.Codec[V]) error {
+	return container.WriteBinary(w, t.List(), kc, vc)
+}
+
+// ReadBinary replaces the content of the tree with the elements written by WriteBinary in O(n).
+// The tree must have been created by New or NewT.
+func (t *SearchTree[K, V]) ReadBinary(r io.Reader, kc container.Codec[K], vc container.Codec[V]) error {
+	list, err := container.ReadBinary(r, kc, vc)
+	if err != nil {
+		return err
+	}
+	return t.build(list)
+}
+
+func (t *SearchTree[K, V]) toList() []container.KV[K, V] {
+	var ret []container.KV[K, V]
+	for n := t.min(t.root); n != t.nil; n = t.successor(n) {
+		ret = append(ret, container.KV[K, V]{K: n.key, V: n.value})
+	}
+	return ret
+}
+
+// build replaces the content of the tree with the given elements, which must be in strictly
+// increasing key order. The tree is perfectly balanced, with the nodes on the bottom level colored
+// red if the level is incomplete, so that all paths have the same number of black nodes.
+func (t *SearchTree[K, V]) build(list []container.KV[K, V]) error {
+	if t.nil == nil {
+		return errors.New("tree not initialized")
+	}
+	for i := 1; i < len(list); i++ {
+		if t.cmp(list[i-1].K, list[i].K) >= 0 {
+			return fmt.Errorf("keys not in strictly increasing order: %v >= %v", list[i-1].K, list[i].K)
+		}
+	}
+
+	n := uint(len(list))
+	bottom := -1
+	if n&(n+1) != 0 { // bottom level incomplete?
+		bottom = bits.Len(n) - 1
+	}
+	t.root = t.buildRange(list, t.nil, 0, bottom)
+	return nil
+}
+
+func (t *SearchTree[K, V]) buildRange(list []container.KV[K, V], parent *node[K, V], depth, bottom int) *node[K, V] {
+	if len(list) == 0 {
+		return t.nil
+	}
+
+	mid := len(list) / 2
+	n := &node[K, V]{parent: parent, key: list[mid].K, value: list[mid].V, color: black}
+	if depth == bottom {
+		n.color = red
+	}
+	n.left = t.buildRange(list[:mid], n, depth+1, bottom)
+	n.right = t.buildRange(list[mid+1:], n, depth+1, bottom)
+	return n
+}
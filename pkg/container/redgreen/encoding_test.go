@@ -0,0 +1,76 @@
+package redgreen_test
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"github.com/seekerror/stdlib/pkg/container"
+	"github.com/seekerror/stdlib/pkg/container/redgreen"
+	"github.com/seekerror/stdlib/pkg/lang"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"strconv"
+	"testing"
+)
+
+func TestSearchTreeEncoding(t *testing.T) {
+	for n := 0; n < 70; n++ {
+		rgt := redgreen.New[int, string]()
+		for i := 0; i < n; i++ {
+			rgt.Insert(-i, strconv.Itoa(i))
+		}
+		expected := lang.ToList(rgt.List())
+
+		// (1) JSON
+
+		data, err := json.Marshal(rgt)
+		require.NoError(t, err)
+
+		fromJSON := redgreen.New[int, string]()
+		require.NoError(t, json.Unmarshal(data, fromJSON))
+		assert.Equal(t, expected, lang.ToList(fromJSON.List()))
+
+		// (2) Gob
+
+		var buf bytes.Buffer
+		require.NoError(t, gob.NewEncoder(&buf).Encode(rgt))
+
+		fromGob := redgreen.New[int, string]()
+		require.NoError(t, gob.NewDecoder(&buf).Decode(fromGob))
+		assert.Equal(t, expected, lang.ToList(fromGob.List()))
+
+		// (3) Binary
+
+		buf.Reset()
+		require.NoError(t, rgt.WriteBinary(&buf, container.VarintCodec[int](), container.StringCodec()))
+
+		fromBinary := redgreen.New[int, string]()
+		require.NoError(t, fromBinary.ReadBinary(&buf, container.VarintCodec[int](), container.StringCodec()))
+		assert.Equal(t, expected, lang.ToList(fromBinary.List()))
+
+		// (4) Loaded tree is a valid search tree
+
+		for i := 0; i < n; i += 2 {
+			v, ok := fromBinary.Remove(-i)
+			assert.True(t, ok)
+			assert.Equal(t, strconv.Itoa(i), v)
+		}
+		for i := 0; i < n; i++ {
+			fromBinary.Insert(i, strconv.Itoa(i))
+		}
+		for i := 0; i < n; i++ {
+			_, ok := fromBinary.Find(-i)
+			assert.Equal(t, i%2 == 1 || i == 0 && n > 0, ok)
+		}
+	}
+}
+
+func TestSearchTreeEncodingUnsorted(t *testing.T) {
+	rgt := redgreen.New[int, string]()
+	assert.Error(t, json.Unmarshal([]byte(`[{"K":2,"V":"a"},{"K":1,"V":"b"}]`), rgt))
+	assert.Error(t, json.Unmarshal([]byte(`[{"K":1,"V":"a"},{"K":1,"V":"b"}]`), rgt))
+
+	data, err := json.Marshal(rgt)
+	require.NoError(t, err)
+	assert.Equal(t, "[]", string(data))
+}
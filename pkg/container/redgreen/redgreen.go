@@ -6,6 +6,7 @@ import (
 	"github.com/seekerror/stdlib/pkg/lang"
 	"github.com/seekerror/stdlib/pkg/util/mathx"
 	"golang.org/x/exp/constraints"
+	"iter"
 )
 
 // color is {red, black}
@@ -51,6 +52,28 @@ func (t *SearchTree[K, V]) List() lang.Iterator[container.KV[K, V]] {
 	return &iterator[K, V]{t: t, next: t.min(t.root)}
 }
 
+// All returns a range-over-func sequence of all elements in increasing key order.
+func (t *SearchTree[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for n := t.min(t.root); n != t.nil; n = t.successor(n) {
+			if !yield(n.key, n.value) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns a range-over-func sequence of all elements in decreasing key order.
+func (t *SearchTree[K, V]) Backward() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for n := t.max(t.root); n != t.nil; n = t.predecessor(n) {
+			if !yield(n.key, n.value) {
+				return
+			}
+		}
+	}
+}
+
 func (t *SearchTree[K, V]) IsEmpty() bool {
 	return t.root == t.nil
 }
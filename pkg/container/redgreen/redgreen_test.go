@@ -1,11 +1,16 @@
 package redgreen_test
 
 import (
+	"github.com/seekerror/stdlib/pkg/container"
+	"github.com/seekerror/stdlib/pkg/container/dicttest"
 	"github.com/seekerror/stdlib/pkg/container/redgreen"
 	"github.com/seekerror/stdlib/pkg/lang"
 	"github.com/seekerror/stdlib/pkg/util/mathx"
+	"github.com/seekerror/stdlib/pkg/util/quickx"
 	"github.com/stretchr/testify/assert"
+	"maps"
 	"math"
+	"strconv"
 	"testing"
 )
 
@@ -52,3 +57,90 @@ func TestSearchTree(t *testing.T) {
 		assert.Equal(t, N/2, len(lang.ToList(rgt.List())))
 	}
 }
+
+func TestSearchTreeAll(t *testing.T) {
+	rgt := redgreen.New[int, string]()
+	for _, k := range []int{3, 1, 2} {
+		rgt.Insert(k, strconv.Itoa(k))
+	}
+
+	var keys []int
+	for k := range rgt.All() {
+		keys = append(keys, k)
+	}
+	assert.Equal(t, []int{1, 2, 3}, keys)
+
+	keys = nil
+	for k := range rgt.Backward() {
+		if k == 1 {
+			break
+		}
+		keys = append(keys, k)
+	}
+	assert.Equal(t, []int{3, 2}, keys)
+
+	assert.Equal(t, map[int]string{1: "1", 2: "2", 3: "3"}, maps.Collect(rgt.All()))
+	assert.Equal(t, map[int]string{1: "1", 2: "2", 3: "3"}, maps.Collect(container.Seq2(rgt.List())))
+}
+
+func TestSearchTreeFromSeq2(t *testing.T) {
+	rgt := redgreen.New[int, string]()
+	for _, k := range []int{3, 1, 2} {
+		rgt.Insert(k, strconv.Itoa(k))
+	}
+
+	it, stop := container.FromSeq2(rgt.All())
+	defer stop()
+	assert.Equal(t, []container.KV[int, string]{{K: 1, V: "1"}, {K: 2, V: "2"}, {K: 3, V: "3"}}, lang.ToList(it))
+
+	// Early termination
+
+	done := false
+	it, stop = container.FromSeq2(func(yield func(int, string) bool) {
+		defer func() { done = true }()
+		for k := range rgt.Backward() {
+			if !yield(k, strconv.Itoa(k)) {
+				return
+			}
+		}
+	})
+	assert.Equal(t, []container.KV[int, string]{{K: 3, V: "3"}}, lang.ToList(lang.Head(it, 1)))
+	assert.False(t, done)
+	stop()
+	assert.True(t, done)
+
+	// Close as stream
+
+	done = false
+	it, _ = container.FromSeq2(func(yield func(int, string) bool) {
+		defer func() { done = true }()
+		yield(1, "1")
+	})
+	s := lang.AsStream(it)
+	_, ok := s.Next()
+	assert.True(t, ok)
+	assert.NoError(t, s.Err())
+	assert.False(t, done)
+	assert.NoError(t, s.Close())
+	assert.True(t, done)
+}
+
+func TestSearchTreeConformance(t *testing.T) {
+	dicttest.Run(t, func() container.Dictionary[int, string] {
+		return redgreen.New[int, string]()
+	}, dicttest.Config[int, string]{
+		Keys:    quickx.Ints(-1000, 1000),
+		Values:  quickx.Strings(),
+		Compare: lang.Compare[int],
+		Check:   quickx.Config{Count: 500, MaxSize: 200},
+	})
+}
+
+func BenchmarkSearchTree(b *testing.B) {
+	keys := lang.ToList(lang.Head(mathx.Numbers(0), 10000))
+	mathx.Shuffle(keys)
+
+	dicttest.Benchmark(b, func() container.Dictionary[int, int] {
+		return redgreen.New[int, int]()
+	}, keys, 0)
+}
@@ -0,0 +1,160 @@
+package segment
+
+// ApplyFn applies the update u to the aggregate t of n values. For example, adding u to each value
+// of a range sum is t + n*u, while for a range max it is t + u.
+type ApplyFn[T, U any] func(t T, u U, n int) T
+
+// ComposeFn returns the single update equivalent to applying a and then b.
+type ComposeFn[U any] func(a, b U) U
+
+// LazyTree is a segment tree over n indexed values, supporting range update and range query in
+// O(log n). Range updates are propagated lazily to subtrees as needed. Not thread-safe.
+type LazyTree[T, U any] struct {
+	tree     []T
+	pending  []U
+	dirty    []bool
+	n        int
+	identity T
+	combine  func(a, b T) T
+	apply    ApplyFn[T, U]
+	compose  ComposeFn[U]
+}
+
+// NewLazy returns a lazy segment tree over the given values in O(n). The combine function must be
+// associative with the given identity and updates must distribute over it. Not thread-safe.
+func NewLazy[T, U any](values []T, identity T, combine func(a, b T) T, apply ApplyFn[T, U], compose ComposeFn[U]) *LazyTree[T, U] {
+	n := len(values)
+	size := 1
+	for size < n {
+		size *= 2
+	}
+
+	ret := &LazyTree[T, U]{
+		tree:     make([]T, 2*size),
+		pending:  make([]U, 2*size),
+		dirty:    make([]bool, 2*size),
+		n:        n,
+		identity: identity,
+		combine:  combine,
+		apply:    apply,
+		compose:  compose,
+	}
+	if n > 0 {
+		ret.build(1, 0, n, values)
+	}
+	return ret
+}
+
+// Len returns the number of indexed values.
+func (t *LazyTree[T, U]) Len() int {
+	return t.n
+}
+
+// Set sets the value at index i.
+func (t *LazyTree[T, U]) Set(i int, v T) {
+	check(i, i+1, t.n)
+	t.set(1, 0, t.n, i, v)
+}
+
+// Update applies the update u to each value at indices [from;to).
+func (t *LazyTree[T, U]) Update(from, to int, u U) {
+	check(from, to, t.n)
+	if from < to {
+		t.update(1, 0, t.n, from, to, u)
+	}
+}
+
+// Query returns the combined values at indices [from;to), in index order.
+func (t *LazyTree[T, U]) Query(from, to int) T {
+	check(from, to, t.n)
+	if from == to {
+		return t.identity
+	}
+	return t.query(1, 0, t.n, from, to)
+}
+
+func (t *LazyTree[T, U]) build(x, lo, hi int, values []T) {
+	if hi-lo == 1 {
+		t.tree[x] = values[lo]
+		return
+	}
+	mid := (lo + hi) / 2
+	t.build(2*x, lo, mid, values)
+	t.build(2*x+1, mid, hi, values)
+	t.tree[x] = t.combine(t.tree[2*x], t.tree[2*x+1])
+}
+
+func (t *LazyTree[T, U]) set(x, lo, hi, i int, v T) {
+	if hi-lo == 1 {
+		t.tree[x] = v
+		return
+	}
+	t.push(x, lo, hi)
+
+	mid := (lo + hi) / 2
+	if i < mid {
+		t.set(2*x, lo, mid, i, v)
+	} else {
+		t.set(2*x+1, mid, hi, i, v)
+	}
+	t.tree[x] = t.combine(t.tree[2*x], t.tree[2*x+1])
+}
+
+func (t *LazyTree[T, U]) update(x, lo, hi, from, to int, u U) {
+	if from <= lo && hi <= to {
+		t.mark(x, hi-lo, u)
+		return
+	}
+	t.push(x, lo, hi)
+
+	mid := (lo + hi) / 2
+	if from < mid {
+		t.update(2*x, lo, mid, from, to, u)
+	}
+	if mid < to {
+		t.update(2*x+1, mid, hi, from, to, u)
+	}
+	t.tree[x] = t.combine(t.tree[2*x], t.tree[2*x+1])
+}
+
+func (t *LazyTree[T, U]) query(x, lo, hi, from, to int) T {
+	if from <= lo && hi <= to {
+		return t.tree[x]
+	}
+	t.push(x, lo, hi)
+
+	mid := (lo + hi) / 2
+	switch {
+	case to <= mid:
+		return t.query(2*x, lo, mid, from, to)
+	case mid <= from:
+		return t.query(2*x+1, mid, hi, from, to)
+	default:
+		return t.combine(t.query(2*x, lo, mid, from, to), t.query(2*x+1, mid, hi, from, to))
+	}
+}
+
+// mark applies u to the node x covering n values and records it as pending for its subtree.
+func (t *LazyTree[T, U]) mark(x, n int, u U) {
+	t.tree[x] = t.apply(t.tree[x], u, n)
+	if t.dirty[x] {
+		t.pending[x] = t.compose(t.pending[x], u)
+	} else {
+		t.pending[x] = u
+		t.dirty[x] = true
+	}
+}
+
+// push propagates any pending update of node x to its children.
+func (t *LazyTree[T, U]) push(x, lo, hi int) {
+	if !t.dirty[x] {
+		return
+	}
+	mid := (lo + hi) / 2
+	t.mark(2*x, mid-lo, t.pending[x])
+	t.mark(2*x+1, hi-mid, t.pending[x])
+
+	var u U
+	t.pending[x] = u
+	t.dirty[x] = false
+}
@@ -0,0 +1,76 @@
+// Package segment contains generic implementations of segment trees for range queries.
+package segment
+
+import "fmt"
+
+// Tree is a segment tree over n indexed values, supporting point update and range query in
+// O(log n). The combine function must be associative with the given identity, such as addition
+// with 0 or max with the smallest value. Not thread-safe.
+type Tree[T any] struct {
+	tree     []T // tree[n+i] is the value at index i and tree[i] = combine(tree[2i], tree[2i+1])
+	n        int
+	identity T
+	combine  func(a, b T) T
+}
+
+// New returns a segment tree over the given values in O(n). Not thread-safe.
+func New[T any](values []T, identity T, combine func(a, b T) T) *Tree[T] {
+	n := len(values)
+	tree := make([]T, 2*n)
+	copy(tree[n:], values)
+	for i := n - 1; i > 0; i-- {
+		tree[i] = combine(tree[2*i], tree[2*i+1])
+	}
+	return &Tree[T]{
+		tree:     tree,
+		n:        n,
+		identity: identity,
+		combine:  combine,
+	}
+}
+
+// Len returns the number of indexed values.
+func (t *Tree[T]) Len() int {
+	return t.n
+}
+
+// Get returns the value at index i.
+func (t *Tree[T]) Get(i int) T {
+	check(i, i+1, t.n)
+	return t.tree[t.n+i]
+}
+
+// Set sets the value at index i.
+func (t *Tree[T]) Set(i int, v T) {
+	check(i, i+1, t.n)
+	i += t.n
+	t.tree[i] = v
+	for i > 1 {
+		i /= 2
+		t.tree[i] = t.combine(t.tree[2*i], t.tree[2*i+1])
+	}
+}
+
+// Query returns the combined values at indices [from;to), in index order.
+func (t *Tree[T]) Query(from, to int) T {
+	check(from, to, t.n)
+
+	left, right := t.identity, t.identity
+	for from, to = from+t.n, to+t.n; from < to; from, to = from/2, to/2 {
+		if from&1 == 1 {
+			left = t.combine(left, t.tree[from])
+			from++
+		}
+		if to&1 == 1 {
+			to--
+			right = t.combine(t.tree[to], right)
+		}
+	}
+	return t.combine(left, right)
+}
+
+func check(from, to, n int) {
+	if from < 0 || to < from || to > n {
+		panic(fmt.Sprintf("segment: range [%v;%v) out of bounds [0;%v)", from, to, n))
+	}
+}
@@ -0,0 +1,83 @@
+package segment_test
+
+import (
+	"github.com/seekerror/stdlib/pkg/container/segment"
+	"github.com/seekerror/stdlib/pkg/util/mathx"
+	"github.com/stretchr/testify/assert"
+	"math"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestTree(t *testing.T) {
+	const N = 37
+
+	letters := make([]string, N)
+	for i := range letters {
+		letters[i] = string(rune('a' + i%26))
+	}
+	tree := segment.New(letters, "", func(a, b string) string {
+		return a + b
+	})
+
+	for k := 0; k < 1000; k++ {
+		i := rand.Intn(N)
+		letters[i] = string(rune('A' + rand.Intn(26)))
+		tree.Set(i, letters[i])
+
+		from := rand.Intn(N + 1)
+		to := from + rand.Intn(N+1-from)
+		assert.Equal(t, strings.Join(letters[from:to], ""), tree.Query(from, to))
+	}
+}
+
+func TestLazyTree(t *testing.T) {
+	const N = 53
+
+	values := make([]int, N)
+	sum := segment.NewLazy(values, 0, func(a, b int) int {
+		return a + b
+	}, func(t, u, n int) int {
+		return t + n*u
+	}, func(a, b int) int {
+		return a + b
+	})
+	max := segment.NewLazy(values, math.MinInt, func(a, b int) int {
+		return mathx.Max(a, b)
+	}, func(t, u, n int) int {
+		return t + u
+	}, func(a, b int) int {
+		return a + b
+	})
+
+	for k := 0; k < 1000; k++ {
+		from := rand.Intn(N + 1)
+		to := from + rand.Intn(N+1-from)
+
+		if rand.Intn(4) == 0 {
+			v := rand.Intn(100)
+			values[from%N] = v
+			sum.Set(from%N, v)
+			max.Set(from%N, v)
+		} else {
+			u := rand.Intn(21) - 10
+			for i := from; i < to; i++ {
+				values[i] += u
+			}
+			sum.Update(from, to, u)
+			max.Update(from, to, u)
+		}
+
+		from = rand.Intn(N + 1)
+		to = from + rand.Intn(N+1-from)
+
+		s, m := 0, math.MinInt
+		for _, v := range values[from:to] {
+			s += v
+			m = mathx.Max(m, v)
+		}
+		assert.Equal(t, s, sum.Query(from, to))
+		assert.Equal(t, m, max.Query(from, to))
+	}
+}
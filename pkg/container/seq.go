@@ -0,0 +1,50 @@
+package container
+
+import (
+	"github.com/seekerror/stdlib/pkg/lang"
+	"iter"
+)
+
+// Seq2 returns a single-use range-over-func sequence of key-value pairs of the iterator values.
+// Ranging over it consumes the iterator.
+func Seq2[K, V any](it lang.Iterator[KV[K, V]]) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for {
+			kv, ok := it.Next()
+			if !ok || !yield(kv.K, kv.V) {
+				return
+			}
+		}
+	}
+}
+
+// FromSeq2 returns an iterator over the sequence key-value pairs, which are computed lazily using
+// iter.Pull2. The returned stop function must be called if the iterator is not exhausted to release
+// its resources. It is idempotent. The iterator is also a Stream, whose Close calls stop.
+func FromSeq2[K, V any](seq iter.Seq2[K, V]) (lang.Iterator[KV[K, V]], func()) {
+	next, stop := iter.Pull2(seq)
+	return &pulled[K, V]{next: next, stop: stop}, stop
+}
+
+type pulled[K, V any] struct {
+	next func() (K, V, bool)
+	stop func()
+}
+
+func (it *pulled[K, V]) Next() (KV[K, V], bool) {
+	k, v, ok := it.next()
+	if !ok {
+		it.stop()
+		return KV[K, V]{}, false
+	}
+	return KV[K, V]{K: k, V: v}, true
+}
+
+func (it *pulled[K, V]) Err() error {
+	return nil
+}
+
+func (it *pulled[K, V]) Close() error {
+	it.stop()
+	return nil
+}
@@ -0,0 +1,345 @@
+package lang
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Filter selects values of an iterator lazily.
+func Filter[T any](it Iterator[T], fn func(t T) bool) Iterator[T] {
+	return MapIf(it, func(t T) (T, bool) {
+		return t, fn(t)
+	})
+}
+
+// FlatMap transforms each value of an iterator into an iterator and flattens the result lazily.
+// Each transformed iterator is closed when exhausted, if a stream.
+func FlatMap[T, U any](it Iterator[T], fn func(t T) Iterator[U]) Iterator[U] {
+	return &flatMapped[T, U]{upstream: upstream[T]{it: it}, fn: fn}
+}
+
+type flatMapped[T, U any] struct {
+	upstream[T]
+	fn  func(t T) Iterator[U]
+	cur Stream[U]
+	err error
+}
+
+func (it *flatMapped[T, U]) Next() (U, bool) {
+	for it.err == nil {
+		if it.cur != nil {
+			if u, ok := it.cur.Next(); ok {
+				return u, true
+			}
+			it.err = errors.Join(it.cur.Err(), it.cur.Close())
+			it.cur = nil
+			continue
+		}
+		t, ok := it.it.Next()
+		if !ok {
+			break
+		}
+		it.cur = AsStream(it.fn(t))
+	}
+	var u U
+	return u, false
+}
+
+func (it *flatMapped[T, U]) Err() error {
+	return errors.Join(it.err, it.upstream.Err())
+}
+
+func (it *flatMapped[T, U]) Close() error {
+	var err error
+	if it.cur != nil {
+		err = it.cur.Close()
+		it.cur = nil
+	}
+	return errors.Join(err, it.upstream.Close())
+}
+
+// Concat chains iterators lazily, returning all values of the first iterator, then the second
+// and so on. It stops at the first failed stream.
+func Concat[T any](its ...Iterator[T]) Iterator[T] {
+	return &concat[T]{its: its, all: its}
+}
+
+type concat[T any] struct {
+	its, all []Iterator[T]
+	err      error
+}
+
+func (it *concat[T]) Next() (T, bool) {
+	for len(it.its) > 0 && it.err == nil {
+		if t, ok := it.its[0].Next(); ok {
+			return t, true
+		}
+		it.err = AsStream(it.its[0]).Err()
+		it.its = it.its[1:]
+	}
+	var t T
+	return t, false
+}
+
+func (it *concat[T]) Err() error {
+	return it.err
+}
+
+func (it *concat[T]) Close() error {
+	var errs []error
+	for _, s := range it.all {
+		errs = append(errs, AsStream(s).Close())
+	}
+	return errors.Join(errs...)
+}
+
+// ZipWith combines the values of two iterators pairwise lazily. It stops when either iterator is
+// exhausted.
+func ZipWith[T, U, R any](a Iterator[T], b Iterator[U], fn func(t T, u U) R) Iterator[R] {
+	return &zipped[T, U, R]{a: a, b: b, fn: fn}
+}
+
+type zipped[T, U, R any] struct {
+	a  Iterator[T]
+	b  Iterator[U]
+	fn func(t T, u U) R
+}
+
+func (it *zipped[T, U, R]) Next() (R, bool) {
+	if t, ok := it.a.Next(); ok {
+		if u, ok := it.b.Next(); ok {
+			return it.fn(t, u), true
+		}
+	}
+	var r R
+	return r, false
+}
+
+func (it *zipped[T, U, R]) Err() error {
+	return errors.Join(AsStream(it.a).Err(), AsStream(it.b).Err())
+}
+
+func (it *zipped[T, U, R]) Close() error {
+	return errors.Join(AsStream(it.a).Close(), AsStream(it.b).Close())
+}
+
+// Indexed is a value with its 0-based position in an iterator.
+type Indexed[T any] struct {
+	I int
+	V T
+}
+
+func (i Indexed[T]) String() string {
+	return fmt.Sprintf("%v:%v", i.I, i.V)
+}
+
+// Enumerate adds the 0-based position to each value of an iterator lazily.
+func Enumerate[T any](it Iterator[T]) Iterator[Indexed[T]] {
+	i := 0
+	return Map(it, func(t T) Indexed[T] {
+		ret := Indexed[T]{I: i, V: t}
+		i++
+		return ret
+	})
+}
+
+// Skip skips the first N values of an iterator lazily.
+func Skip[T any](it Iterator[T], n int) Iterator[T] {
+	return DropWhile(it, func(t T) bool {
+		n--
+		return n >= 0
+	})
+}
+
+// TakeWhile returns values of an iterator lazily, until the first value that does not satisfy
+// the predicate.
+func TakeWhile[T any](it Iterator[T], fn func(t T) bool) Iterator[T] {
+	return &takeWhile[T]{upstream: upstream[T]{it: it}, fn: fn}
+}
+
+type takeWhile[T any] struct {
+	upstream[T]
+	fn   func(t T) bool
+	done bool
+}
+
+func (it *takeWhile[T]) Next() (T, bool) {
+	if !it.done {
+		if t, ok := it.it.Next(); ok && it.fn(t) {
+			return t, true
+		}
+		it.done = true
+	}
+	var t T
+	return t, false
+}
+
+// DropWhile skips values of an iterator lazily, until the first value that does not satisfy the
+// predicate.
+func DropWhile[T any](it Iterator[T], fn func(t T) bool) Iterator[T] {
+	return &dropWhile[T]{upstream: upstream[T]{it: it}, fn: fn}
+}
+
+type dropWhile[T any] struct {
+	upstream[T]
+	fn      func(t T) bool
+	dropped bool
+}
+
+func (it *dropWhile[T]) Next() (T, bool) {
+	if !it.dropped {
+		it.dropped = true
+		for {
+			t, ok := it.it.Next()
+			if !ok || !it.fn(t) {
+				return t, ok
+			}
+		}
+	}
+	return it.it.Next()
+}
+
+// Chunk groups the values of an iterator into consecutive lists of N values lazily. The last list
+// may be shorter.
+func Chunk[T any](it Iterator[T], n int) Iterator[[]T] {
+	if n < 1 {
+		panic(fmt.Sprintf("invalid chunk size: %v", n))
+	}
+	return &chunk[T]{upstream: upstream[T]{it: it}, n: n}
+}
+
+type chunk[T any] struct {
+	upstream[T]
+	n int
+}
+
+func (it *chunk[T]) Next() ([]T, bool) {
+	var ret []T
+	for len(ret) < it.n {
+		t, ok := it.it.Next()
+		if !ok {
+			break
+		}
+		ret = append(ret, t)
+	}
+	return ret, len(ret) > 0
+}
+
+// Window returns each sliding window of N consecutive values of an iterator lazily. Each window is
+// a new list. If the iterator has fewer than N values, there are no windows.
+func Window[T any](it Iterator[T], n int) Iterator[[]T] {
+	if n < 1 {
+		panic(fmt.Sprintf("invalid window size: %v", n))
+	}
+	return &window[T]{upstream: upstream[T]{it: it}, n: n}
+}
+
+type window[T any] struct {
+	upstream[T]
+	n   int
+	cur []T
+}
+
+func (it *window[T]) Next() ([]T, bool) {
+	if len(it.cur) == it.n {
+		it.cur = it.cur[1:]
+	}
+	for len(it.cur) < it.n {
+		t, ok := it.it.Next()
+		if !ok {
+			it.cur = nil
+			return nil, false
+		}
+		it.cur = append(it.cur, t)
+	}
+
+	ret := make([]T, it.n)
+	copy(ret, it.cur)
+	return ret, true
+}
+
+// Distinct skips values already seen in an iterator lazily. It retains all distinct values.
+func Distinct[T comparable](it Iterator[T]) Iterator[T] {
+	seen := map[T]bool{}
+	return Filter(it, func(t T) bool {
+		if seen[t] {
+			return false
+		}
+		seen[t] = true
+		return true
+	})
+}
+
+// DistinctT skips values already seen in an iterator lazily, using the given equality function.
+// It retains all distinct values and is quadratic in their number.
+func DistinctT[T any](it Iterator[T], eq EqualsFn[T]) Iterator[T] {
+	var seen []T
+	return Filter(it, func(t T) bool {
+		for _, s := range seen {
+			if eq(s, t) {
+				return false
+			}
+		}
+		seen = append(seen, t)
+		return true
+	})
+}
+
+// Fold combines all values of an iterator, starting from the given initial value.
+func Fold[T, U any](it Iterator[T], init U, fn func(u U, t T) U) U {
+	ret := init
+	for {
+		t, ok := it.Next()
+		if !ok {
+			return ret
+		}
+		ret = fn(ret, t)
+	}
+}
+
+// Reduce combines all values of an iterator, starting from the first value. False if empty.
+func Reduce[T any](it Iterator[T], fn func(a, b T) T) (T, bool) {
+	ret, ok := it.Next()
+	if !ok {
+		return ret, false
+	}
+	return Fold(it, ret, fn), true
+}
+
+// Any returns true iff some value of an iterator satisfies the predicate. It stops at the first
+// such value.
+func Any[T any](it Iterator[T], fn func(t T) bool) bool {
+	_, ok := First(Filter(it, fn))
+	return ok
+}
+
+// All returns true iff every value of an iterator satisfies the predicate. It stops at the first
+// value that does not.
+func All[T any](it Iterator[T], fn func(t T) bool) bool {
+	return !Any(it, func(t T) bool {
+		return !fn(t)
+	})
+}
+
+// Count returns the number of values of an iterator.
+func Count[T any](it Iterator[T]) int {
+	return Fold(it, 0, func(n int, t T) int {
+		return n + 1
+	})
+}
+
+// First returns the first value of an iterator. False if empty.
+func First[T any](it Iterator[T]) (T, bool) {
+	return it.Next()
+}
+
+// Last returns the last value of an iterator. False if empty.
+func Last[T any](it Iterator[T]) (T, bool) {
+	ret, ok := it.Next()
+	if !ok {
+		return ret, false
+	}
+	return Fold(it, ret, func(_, t T) T {
+		return t
+	}), true
+}
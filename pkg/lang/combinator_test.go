@@ -0,0 +1,108 @@
+package lang_test
+
+import (
+	"github.com/seekerror/stdlib/pkg/lang"
+	"github.com/seekerror/stdlib/pkg/util/mathx"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+// counter is an infinite iterator 0, 1, 2, ..., which counts how many values were consumed.
+type counter struct {
+	n int
+}
+
+func (c *counter) Next() (int, bool) {
+	ret := c.n
+	c.n++
+	return ret, true
+}
+
+func even(n int) bool {
+	return n%2 == 0
+}
+
+func TestCombinators(t *testing.T) {
+	ints := func(n int) lang.Iterator[int] {
+		return lang.Head(mathx.Numbers(0), n)
+	}
+
+	assert.Equal(t, []int{0, 2, 4}, lang.ToList(lang.Filter(ints(6), even)))
+	assert.Equal(t, []int{1, 2, 3}, lang.ToList(lang.FlatMap(ints(3), func(n int) lang.Iterator[int] {
+		return lang.Head(mathx.Numbers(n), n)
+	})))
+	assert.Equal(t, []int{0, 1, 0, 1, 2}, lang.ToList(lang.Concat(ints(2), ints(0), ints(3))))
+	assert.Equal(t, []int{0, 2, 4}, lang.ToList(lang.ZipWith(ints(3), ints(5), func(a, b int) int {
+		return a + b
+	})))
+	assert.Equal(t, "[0:a, 1:b]", lang.Sprint(lang.Enumerate(lang.Map(ints(2), func(n int) string {
+		return string(rune('a' + n))
+	}))))
+	assert.Equal(t, []int{3, 4}, lang.ToList(lang.Skip(ints(5), 3)))
+	assert.Equal(t, 0, len(lang.ToList(lang.Skip(ints(2), 3))))
+	assert.Equal(t, []int{0, 1, 2}, lang.ToList(lang.TakeWhile(ints(5), func(n int) bool {
+		return n < 3
+	})))
+	assert.Equal(t, []int{3, 4, 0}, lang.ToList(lang.DropWhile(lang.Concat(ints(5), ints(1)), func(n int) bool {
+		return n < 3
+	})))
+	assert.Equal(t, [][]int{{0, 1}, {2, 3}, {4}}, lang.ToList(lang.Chunk(ints(5), 2)))
+	assert.Equal(t, [][]int{{0, 1, 2}, {1, 2, 3}}, lang.ToList(lang.Window(ints(4), 3)))
+	assert.Equal(t, 0, len(lang.ToList(lang.Window(ints(2), 3))))
+	assert.Equal(t, []int{0, 1, 2}, lang.ToList(lang.Distinct(lang.Concat(ints(2), ints(3)))))
+	assert.Equal(t, []string{"a", "B"}, lang.ToList(lang.DistinctT(lang.Map(ints(4), func(n int) string {
+		return []string{"a", "B", "A", "b"}[n]
+	}), strings.EqualFold)))
+
+	assert.Equal(t, "01234", lang.Fold(ints(5), "", func(s string, n int) string {
+		return s + string(rune('0'+n))
+	}))
+	sum, ok := lang.Reduce(ints(5), func(a, b int) int {
+		return a + b
+	})
+	assert.True(t, ok)
+	assert.Equal(t, 10, sum)
+	_, ok = lang.Reduce(ints(0), func(a, b int) int {
+		return a + b
+	})
+	assert.False(t, ok)
+
+	assert.True(t, lang.All(ints(0), even))
+	assert.False(t, lang.All(ints(3), even))
+	assert.True(t, lang.Any(ints(3), even))
+	assert.False(t, lang.Any(ints(0), even))
+	assert.Equal(t, 7, lang.Count(ints(7)))
+
+	last, ok := lang.Last(ints(7))
+	assert.True(t, ok)
+	assert.Equal(t, 6, last)
+	_, ok = lang.First(ints(0))
+	assert.False(t, ok)
+}
+
+func TestCombinatorsAreLazy(t *testing.T) {
+	c := &counter{}
+	v, ok := lang.First(lang.Skip(lang.Filter[int](c, even), 2))
+	assert.True(t, ok)
+	assert.Equal(t, 4, v)
+	assert.Equal(t, 5, c.n)
+
+	c = &counter{}
+	lang.ToList(lang.Head(lang.Chunk[int](c, 3), 2))
+	assert.Equal(t, 6, c.n)
+
+	c = &counter{}
+	assert.True(t, lang.Any(lang.Window[int](c, 2), func(w []int) bool {
+		return w[1] == 3
+	}))
+	assert.Equal(t, 4, c.n)
+
+	c = &counter{}
+	assert.False(t, lang.All(lang.TakeWhile(lang.Distinct[int](c), func(n int) bool {
+		return n < 10
+	}), func(n int) bool {
+		return n < 2
+	}))
+	assert.Equal(t, 3, c.n)
+}
@@ -0,0 +1,145 @@
+package lang
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Either represents a value of either type L or type R. Default value is Left of the zero value.
+// It is encoded in JSON as {"type": "left"|"right", "value": ...}.
+type Either[L, R any] struct {
+	l     L
+	r     R
+	right bool
+}
+
+func Left[L, R any](l L) Either[L, R] {
+	return Either[L, R]{l: l}
+}
+
+func Right[L, R any](r R) Either[L, R] {
+	return Either[L, R]{r: r, right: true}
+}
+
+// FoldEither transforms the value using the function for its side.
+func FoldEither[L, R, T any](e Either[L, R], onLeft func(l L) T, onRight func(r R) T) T {
+	if e.right {
+		return onRight(e.r)
+	}
+	return onLeft(e.l)
+}
+
+// Match calls the function for the side of the value.
+func Match[L, R any](e Either[L, R], onLeft func(l L), onRight func(r R)) {
+	if e.right {
+		onRight(e.r)
+	} else {
+		onLeft(e.l)
+	}
+}
+
+// MapLeft transforms the value, if Left.
+func MapLeft[L, R, T any](e Either[L, R], fn func(l L) T) Either[T, R] {
+	if e.right {
+		return Right[T](e.r)
+	}
+	return Left[T, R](fn(e.l))
+}
+
+// MapRight transforms the value, if Right.
+func MapRight[L, R, T any](e Either[L, R], fn func(r R) T) Either[L, T] {
+	if e.right {
+		return Right[L](fn(e.r))
+	}
+	return Left[L, T](e.l)
+}
+
+// PartitionEither materializes all iterator values into lists of Left and Right values.
+func PartitionEither[L, R any](it Iterator[Either[L, R]]) ([]L, []R) {
+	var ls []L
+	var rs []R
+	for {
+		e, ok := it.Next()
+		if !ok {
+			return ls, rs
+		}
+		Match(e, func(l L) {
+			ls = append(ls, l)
+		}, func(r R) {
+			rs = append(rs, r)
+		})
+	}
+}
+
+// IsLeft returns true iff the value is Left.
+func (e Either[L, R]) IsLeft() bool {
+	return !e.right
+}
+
+// IsRight returns true iff the value is Right.
+func (e Either[L, R]) IsRight() bool {
+	return e.right
+}
+
+// Left returns the value. False if not Left.
+func (e Either[L, R]) Left() (L, bool) {
+	return e.l, !e.right
+}
+
+// Right returns the value. False if not Right.
+func (e Either[L, R]) Right() (R, bool) {
+	return e.r, e.right
+}
+
+func (e Either[L, R]) String() string {
+	if e.right {
+		return fmt.Sprintf("right(%v)", e.r)
+	}
+	return fmt.Sprintf("left(%v)", e.l)
+}
+
+type eitherJSON struct {
+	Type  string          `json:"type"`
+	Value json.RawMessage `json:"value"`
+}
+
+func (e Either[L, R]) MarshalJSON() ([]byte, error) {
+	var ret eitherJSON
+	var err error
+	if e.right {
+		ret.Type = "right"
+		ret.Value, err = json.Marshal(e.r)
+	} else {
+		ret.Type = "left"
+		ret.Value, err = json.Marshal(e.l)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(ret)
+}
+
+func (e *Either[L, R]) UnmarshalJSON(data []byte) error {
+	var raw eitherJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	switch raw.Type {
+	case "left":
+		var l L
+		if err := json.Unmarshal(raw.Value, &l); err != nil {
+			return err
+		}
+		*e = Left[L, R](l)
+	case "right":
+		var r R
+		if err := json.Unmarshal(raw.Value, &r); err != nil {
+			return err
+		}
+		*e = Right[L](r)
+	default:
+		return fmt.Errorf("invalid either type: '%v'", raw.Type)
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package lang_test
+
+import (
+	"encoding/json"
+	"github.com/seekerror/stdlib/pkg/lang"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"strconv"
+	"testing"
+)
+
+func TestEither(t *testing.T) {
+	l, r := lang.Left[int, string](1), lang.Right[int]("a")
+
+	assert.True(t, l.IsLeft())
+	assert.True(t, r.IsRight())
+	v, ok := r.Right()
+	assert.True(t, ok)
+	assert.Equal(t, "a", v)
+	_, ok = r.Left()
+	assert.False(t, ok)
+
+	str := func(e lang.Either[int, string]) string {
+		return lang.FoldEither(e, strconv.Itoa, func(s string) string {
+			return s + "!"
+		})
+	}
+	assert.Equal(t, "1", str(l))
+	assert.Equal(t, "a!", str(r))
+
+	assert.Equal(t, "left(2)", lang.MapLeft(l, func(n int) int { return n + 1 }).String())
+	assert.Equal(t, "left(1)", lang.MapRight(l, func(s string) int { return len(s) }).String())
+	assert.Equal(t, "right(1)", lang.MapRight(r, func(s string) int { return len(s) }).String())
+
+	ls, rs := lang.PartitionEither(lang.FromSlice([]lang.Either[int, string]{l, r, l}))
+	assert.Equal(t, []int{1, 1}, ls)
+	assert.Equal(t, []string{"a"}, rs)
+}
+
+func TestEitherJSON(t *testing.T) {
+	list := []lang.Either[int, string]{lang.Left[int, string](1), lang.Right[int]("a")}
+
+	data, err := json.Marshal(list)
+	require.NoError(t, err)
+	assert.Equal(t, `[{"type":"left","value":1},{"type":"right","value":"a"}]`, string(data))
+
+	var decoded []lang.Either[int, string]
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, list, decoded)
+
+	assert.Error(t, json.Unmarshal([]byte(`[{"type":"up","value":1}]`), &decoded))
+	assert.Error(t, json.Unmarshal([]byte(`[{"type":"left","value":"x"}]`), &decoded))
+}
@@ -0,0 +1,77 @@
+package lang
+
+import "iter"
+
+// Generate returns an iterator over the values yielded by a push-style producer, which runs as a
+// coroutine and is resumed lazily for each value. The producer should stop when yield returns
+// false. Close stops the producer early, if the stream is not exhausted.
+func Generate[T any](fn func(yield func(T) bool)) Stream[T] {
+	return newPulled(iter.Seq[T](fn))
+}
+
+// FromFunc returns an iterator over the values returned by the function, until it returns false.
+func FromFunc[T any](fn func() (T, bool)) Iterator[T] {
+	return funcIterator[T](fn)
+}
+
+type funcIterator[T any] func() (T, bool)
+
+func (fn funcIterator[T]) Next() (T, bool) {
+	return fn()
+}
+
+// FromSlice returns an iterator over the elements of a slice.
+func FromSlice[T any](list []T) Iterator[T] {
+	return FromFunc(func() (T, bool) {
+		if len(list) == 0 {
+			var t T
+			return t, false
+		}
+		ret := list[0]
+		list = list[1:]
+		return ret, true
+	})
+}
+
+// Empty returns an iterator with no values.
+func Empty[T any]() Iterator[T] {
+	return FromFunc(func() (T, bool) {
+		var t T
+		return t, false
+	})
+}
+
+// Repeat returns an infinite iterator of the given value.
+func Repeat[T any](t T) Iterator[T] {
+	return FromFunc(func() (T, bool) {
+		return t, true
+	})
+}
+
+// Iterate returns an infinite iterator of seed, fn(seed), fn(fn(seed)), ...
+func Iterate[T any](seed T, fn func(t T) T) Iterator[T] {
+	next := seed
+	return FromFunc(func() (T, bool) {
+		ret := next
+		next = fn(next)
+		return ret, true
+	})
+}
+
+// Unfold returns an iterator of the values produced by repeatedly applying the function to a
+// state, starting from the seed, until it returns false.
+func Unfold[S, T any](seed S, fn func(s S) (T, S, bool)) Iterator[T] {
+	state, done := seed, false
+	return FromFunc(func() (T, bool) {
+		if !done {
+			t, next, ok := fn(state)
+			if ok {
+				state = next
+				return t, true
+			}
+			done = true
+		}
+		var t T
+		return t, false
+	})
+}
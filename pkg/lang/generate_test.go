@@ -0,0 +1,52 @@
+package lang_test
+
+import (
+	"github.com/seekerror/stdlib/pkg/lang"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+type tree struct {
+	left, right *tree
+	v           int
+}
+
+func (t *tree) walk(yield func(int) bool) bool {
+	return t == nil || t.left.walk(yield) && yield(t.v) && t.right.walk(yield)
+}
+
+func TestGenerate(t *testing.T) {
+	root := &tree{v: 2, left: &tree{v: 1}, right: &tree{v: 4, left: &tree{v: 3}}}
+
+	it := lang.Generate(func(yield func(int) bool) {
+		root.walk(yield)
+	})
+	assert.Equal(t, []int{1, 2, 3, 4}, lang.ToList[int](it))
+
+	// Early termination
+
+	stopped := false
+	it = lang.Generate(func(yield func(int) bool) {
+		defer func() { stopped = true }()
+		for i := 0; yield(i); i++ {
+		}
+	})
+	assert.Equal(t, []int{0, 1, 2}, lang.ToList(lang.Head[int](it, 3)))
+	assert.False(t, stopped)
+	assert.NoError(t, it.Close())
+	assert.True(t, stopped)
+}
+
+func TestConstructors(t *testing.T) {
+	assert.Equal(t, []int{1, 2}, lang.ToList(lang.FromSlice([]int{1, 2})))
+	assert.Equal(t, 0, lang.Count(lang.Empty[int]()))
+	assert.Equal(t, []string{"a", "a"}, lang.ToList(lang.Head(lang.Repeat("a"), 2)))
+	assert.Equal(t, []int{1, 2, 4, 8}, lang.ToList(lang.Head(lang.Iterate(1, func(n int) int {
+		return 2 * n
+	}), 4)))
+
+	fib := lang.Unfold([2]int{0, 1}, func(s [2]int) (int, [2]int, bool) {
+		return s[0], [2]int{s[1], s[0] + s[1]}, s[0] < 10
+	})
+	assert.Equal(t, []int{0, 1, 1, 2, 3, 5, 8}, lang.ToList(fib))
+}
@@ -0,0 +1,105 @@
+package lang
+
+import "fmt"
+
+// GroupBy materializes all iterator values into lists by key.
+func GroupBy[T any, K comparable](it Iterator[T], key func(t T) K) map[K][]T {
+	ret := map[K][]T{}
+	for {
+		t, ok := it.Next()
+		if !ok {
+			return ret
+		}
+		k := key(t)
+		ret[k] = append(ret[k], t)
+	}
+}
+
+// CountBy counts all iterator values by key.
+func CountBy[T any, K comparable](it Iterator[T], key func(t T) K) map[K]int {
+	ret := map[K]int{}
+	for {
+		t, ok := it.Next()
+		if !ok {
+			return ret
+		}
+		ret[key(t)]++
+	}
+}
+
+// IndexBy materializes all iterator values by key. Returns an error on the first duplicate key.
+func IndexBy[T any, K comparable](it Iterator[T], key func(t T) K) (map[K]T, error) {
+	ret := map[K]T{}
+	for {
+		t, ok := it.Next()
+		if !ok {
+			return ret, nil
+		}
+		k := key(t)
+		if _, ok := ret[k]; ok {
+			return nil, fmt.Errorf("duplicate key: %v", k)
+		}
+		ret[k] = t
+	}
+}
+
+// Partition materializes all iterator values into the lists of values that satisfy the
+// predicate and those that do not.
+func Partition[T any](it Iterator[T], fn func(t T) bool) ([]T, []T) {
+	var yes, no []T
+	for {
+		t, ok := it.Next()
+		if !ok {
+			return yes, no
+		}
+		if fn(t) {
+			yes = append(yes, t)
+		} else {
+			no = append(no, t)
+		}
+	}
+}
+
+// GroupAdjacent groups consecutive iterator values with equal keys lazily. For an iterator sorted
+// by key, each key is returned once.
+func GroupAdjacent[T any, K comparable](it Iterator[T], key func(t T) K) Iterator[Pair[K, []T]] {
+	return GroupAdjacentT(it, key, Equals[K])
+}
+
+// GroupAdjacentT groups consecutive iterator values with equal keys lazily, using the given
+// equality function.
+func GroupAdjacentT[T, K any](it Iterator[T], key func(t T) K, eq EqualsFn[K]) Iterator[Pair[K, []T]] {
+	return &groupAdjacent[T, K]{it: Peekable(it), key: key, eq: eq}
+}
+
+type groupAdjacent[T, K any] struct {
+	it  *PeekIterator[T]
+	key func(t T) K
+	eq  EqualsFn[K]
+}
+
+func (it *groupAdjacent[T, K]) Next() (Pair[K, []T], bool) {
+	t, ok := it.it.Next()
+	if !ok {
+		return Pair[K, []T]{}, false
+	}
+
+	k := it.key(t)
+	group := []T{t}
+	for {
+		next, ok := it.it.Peek()
+		if !ok || !it.eq(k, it.key(next)) {
+			return NewPair(k, group), true
+		}
+		it.it.Next()
+		group = append(group, next)
+	}
+}
+
+func (it *groupAdjacent[T, K]) Err() error {
+	return it.it.Err()
+}
+
+func (it *groupAdjacent[T, K]) Close() error {
+	return it.it.Close()
+}
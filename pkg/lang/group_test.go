@@ -0,0 +1,40 @@
+package lang_test
+
+import (
+	"github.com/seekerror/stdlib/pkg/lang"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestGroup(t *testing.T) {
+	words := func() lang.Iterator[string] {
+		return lang.FromSlice([]string{"apple", "avocado", "banana", "cherry", "blueberry"})
+	}
+	first := func(s string) byte {
+		return s[0]
+	}
+
+	assert.Equal(t, map[byte][]string{
+		'a': {"apple", "avocado"},
+		'b': {"banana", "blueberry"},
+		'c': {"cherry"},
+	}, lang.GroupBy(words(), first))
+	assert.Equal(t, map[byte]int{'a': 2, 'b': 2, 'c': 1}, lang.CountBy(words(), first))
+
+	_, err := lang.IndexBy(words(), first)
+	assert.Error(t, err)
+	index, err := lang.IndexBy(words(), func(s string) string { return s[1:3] })
+	assert.NoError(t, err)
+	assert.Equal(t, "cherry", index["he"])
+
+	long, short := lang.Partition(words(), func(s string) bool { return len(s) > 6 })
+	assert.Equal(t, []string{"avocado", "blueberry"}, long)
+	assert.Equal(t, []string{"apple", "banana", "cherry"}, short)
+
+	assert.Equal(t, "[(97, [apple avocado]), (98, [banana]), (99, [cherry]), (98, [blueberry])]", lang.Sprint(lang.GroupAdjacent(words(), first)))
+
+	c := &counter{}
+	g, _ := lang.GroupAdjacent[int](c, func(n int) int { return n / 3 }).Next()
+	assert.Equal(t, []int{0, 1, 2}, g.B)
+	assert.Equal(t, 4, c.n)
+}
@@ -0,0 +1,81 @@
+package lang
+
+import (
+	"encoding/binary"
+	"golang.org/x/exp/constraints"
+	"hash/maphash"
+)
+
+// HashFn defines hashing for arbitrary types, consistent with an EqualsFn: equal values must have
+// equal hashes. This function is not a method to avoid a boxing penalty for every natively-hashable
+// object in a data structure. Hashes are seeded, using hash/maphash, and are therefore only stable
+// within a process for a given seed.
+type HashFn[T any] func(t T) uint64
+
+// HashString returns a seeded hash function for strings.
+func HashString(seed maphash.Seed) HashFn[string] {
+	return func(s string) uint64 {
+		return maphash.String(seed, s)
+	}
+}
+
+// HashBytes returns a seeded hash function for byte slices.
+func HashBytes(seed maphash.Seed) HashFn[[]byte] {
+	return func(b []byte) uint64 {
+		return maphash.Bytes(seed, b)
+	}
+}
+
+// HashInteger returns a seeded hash function for integers.
+func HashInteger[T constraints.Integer](seed maphash.Seed) HashFn[T] {
+	return func(t T) uint64 {
+		var buf [8]byte
+		binary.LittleEndian.PutUint64(buf[:], uint64(t))
+		return maphash.Bytes(seed, buf[:])
+	}
+}
+
+// HashStruct returns a seeded hash function for composite values, which writes the relevant
+// fields of the value to the given hash.
+func HashStruct[T any](seed maphash.Seed, fn func(h *maphash.Hash, t T)) HashFn[T] {
+	return func(t T) uint64 {
+		var h maphash.Hash
+		h.SetSeed(seed)
+		fn(&h, t)
+		return h.Sum64()
+	}
+}
+
+// CombineHash combines hashes in an order-dependent way.
+func CombineHash(hashes ...uint64) uint64 {
+	var ret uint64 = 0xcbf29ce484222325
+	for _, h := range hashes {
+		ret ^= h + 0x9e3779b97f4a7c15 + (ret << 6) + (ret >> 2)
+	}
+	return ret
+}
+
+// HashPair returns a hash function for pairs, combining the component hashes.
+func HashPair[A, B any](a HashFn[A], b HashFn[B]) HashFn[Pair[A, B]] {
+	return func(p Pair[A, B]) uint64 {
+		return CombineHash(a(p.A), b(p.B))
+	}
+}
+
+// HashTriple returns a hash function for triples, combining the component hashes.
+func HashTriple[A, B, C any](a HashFn[A], b HashFn[B], c HashFn[C]) HashFn[Triple[A, B, C]] {
+	return func(t Triple[A, B, C]) uint64 {
+		return CombineHash(a(t.A), b(t.B), c(t.C))
+	}
+}
+
+// HashSlice returns a hash function for slices, combining the element hashes in order.
+func HashSlice[T any](fn HashFn[T]) HashFn[[]T] {
+	return func(list []T) uint64 {
+		ret := CombineHash(uint64(len(list)))
+		for _, t := range list {
+			ret = CombineHash(ret, fn(t))
+		}
+		return ret
+	}
+}
@@ -0,0 +1,42 @@
+package lang_test
+
+import (
+	"github.com/seekerror/stdlib/pkg/lang"
+	"github.com/stretchr/testify/assert"
+	"hash/maphash"
+	"strconv"
+	"testing"
+)
+
+func TestHash(t *testing.T) {
+	seed := maphash.MakeSeed()
+
+	str := lang.HashString(seed)
+	assert.Equal(t, str("foo"), str("foo"))
+	assert.NotEqual(t, str("foo"), str("bar"))
+	assert.Equal(t, str("foo"), lang.HashBytes(seed)([]byte("foo")))
+
+	num := lang.HashInteger[int](seed)
+	assert.Equal(t, num(42), num(42))
+	assert.NotEqual(t, num(42), num(43))
+
+	pair := lang.HashPair(str, num)
+	assert.Equal(t, pair(lang.NewPair("a", 1)), pair(lang.NewPair("a", 1)))
+	assert.NotEqual(t, pair(lang.NewPair("a", 1)), pair(lang.NewPair("a", 2)))
+
+	slice := lang.HashSlice(num)
+	assert.NotEqual(t, slice([]int{1, 2}), slice([]int{2, 1}))
+	assert.NotEqual(t, slice([]int{}), slice([]int{0}))
+
+	type point struct {
+		x, y  int
+		label string // not part of identity
+	}
+	fn := lang.HashStruct(seed, func(h *maphash.Hash, p point) {
+		h.WriteString(strconv.Itoa(p.x))
+		h.WriteByte(',')
+		h.WriteString(strconv.Itoa(p.y))
+	})
+	assert.Equal(t, fn(point{1, 2, "a"}), fn(point{1, 2, "b"}))
+	assert.NotEqual(t, fn(point{1, 2, "a"}), fn(point{2, 1, "a"}))
+}
@@ -13,18 +13,18 @@ type Iterator[T any] interface {
 
 // Map transforms values of an iterator lazily.
 func Map[T, U any](it Iterator[T], fn func(t T) U) Iterator[U] {
-	return &mapped[T, U]{it: it, fn: func(t T) (U, bool) {
+	return &mapped[T, U]{upstream: upstream[T]{it: it}, fn: func(t T) (U, bool) {
 		return fn(t), true
 	}}
 }
 
 // MapIf transforms selected values of an iterator lazily.
 func MapIf[T, U any](it Iterator[T], fn func(t T) (U, bool)) Iterator[U] {
-	return &mapped[T, U]{it: it, fn: fn}
+	return &mapped[T, U]{upstream: upstream[T]{it: it}, fn: fn}
 }
 
 type mapped[T, U any] struct {
-	it Iterator[T]
+	upstream[T]
 	fn func(t T) (U, bool)
 }
 
@@ -44,12 +44,12 @@ func (it *mapped[T, U]) Next() (U, bool) {
 
 // Head limits an iterator to N values lazily.
 func Head[T any](it Iterator[T], n int) Iterator[T] {
-	return &head[T]{it: it, n: n}
+	return &head[T]{upstream: upstream[T]{it: it}, n: n}
 }
 
 type head[T any] struct {
-	it Iterator[T]
-	n  int
+	upstream[T]
+	n int
 }
 
 func (it *head[T]) Next() (T, bool) {
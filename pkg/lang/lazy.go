@@ -0,0 +1,131 @@
+package lang
+
+import (
+	"container/list"
+	"fmt"
+	"sync"
+	"sync/atomic"
+)
+
+// Lazy is a lazily-computed value. The value is computed at most once on first use, unless an
+// error is not cached. Thread-safe.
+type Lazy[T any] struct {
+	fn    func() (T, error)
+	retry bool // retry on error?
+
+	mu   sync.Mutex
+	done atomic.Bool
+	t    T
+	err  error
+}
+
+// NewLazy returns a lazy value computed by the given function. The result is cached, including
+// any error.
+func NewLazy[T any](fn func() (T, error)) *Lazy[T] {
+	return &Lazy[T]{fn: fn}
+}
+
+// NewLazyRetry returns a lazy value computed by the given function. Only a successful result is
+// cached. An error is returned to the caller and the computation is retried on next use.
+func NewLazyRetry[T any](fn func() (T, error)) *Lazy[T] {
+	return &Lazy[T]{fn: fn, retry: true}
+}
+
+// Get returns the value, computing it if needed. Concurrent callers wait for the computation.
+func (l *Lazy[T]) Get() (T, error) {
+	if l.done.Load() {
+		return l.t, l.err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.done.Load() {
+		return l.t, l.err
+	}
+	t, err := l.fn()
+	if err != nil && l.retry {
+		return t, err
+	}
+	l.t, l.err = t, err
+	l.fn = nil
+	l.done.Store(true)
+	return t, err
+}
+
+// MustGet returns the value, computing it if needed. Panics on error.
+func (l *Lazy[T]) MustGet() T {
+	t, err := l.Get()
+	if err != nil {
+		panic(fmt.Sprintf("lazy value failed: %v", err))
+	}
+	return t
+}
+
+// Memoize returns a function that caches the results of the given pure function for all arguments.
+// Concurrent first calls with the same argument may compute the result more than once. Thread-safe.
+func Memoize[K comparable, V any](fn func(k K) V) func(k K) V {
+	var mu sync.Mutex
+	cache := map[K]V{}
+
+	return func(k K) V {
+		mu.Lock()
+		v, ok := cache[k]
+		mu.Unlock()
+		if ok {
+			return v
+		}
+
+		v = fn(k)
+
+		mu.Lock()
+		cache[k] = v
+		mu.Unlock()
+		return v
+	}
+}
+
+// MemoizeN returns a function that caches the results of the given pure function for the N most
+// recently used arguments. Concurrent first calls with the same argument may compute the result
+// more than once. Thread-safe.
+func MemoizeN[K comparable, V any](fn func(k K) V, n int) func(k K) V {
+	if n < 1 {
+		panic(fmt.Sprintf("invalid cache size: %v", n))
+	}
+
+	var mu sync.Mutex
+	lru := list.New() // most recently used first
+	cache := map[K]*list.Element{}
+
+	type kv struct {
+		k K
+		v V
+	}
+
+	return func(k K) V {
+		mu.Lock()
+		if e, ok := cache[k]; ok {
+			lru.MoveToFront(e)
+			mu.Unlock()
+			return e.Value.(kv).v
+		}
+		mu.Unlock()
+
+		v := fn(k)
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		if e, ok := cache[k]; ok {
+			lru.MoveToFront(e)
+			return v
+		}
+		cache[k] = lru.PushFront(kv{k: k, v: v})
+		if lru.Len() > n {
+			e := lru.Back()
+			lru.Remove(e)
+			delete(cache, e.Value.(kv).k)
+		}
+		return v
+	}
+}
@@ -0,0 +1,80 @@
+package lang_test
+
+import (
+	"errors"
+	"github.com/seekerror/stdlib/pkg/lang"
+	"github.com/stretchr/testify/assert"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestLazy(t *testing.T) {
+	boom := errors.New("boom")
+
+	// (1) Computed once, concurrently
+
+	var calls atomic.Int32
+	l := lang.NewLazy(func() (int, error) {
+		calls.Add(1)
+		return 42, nil
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.Equal(t, 42, l.MustGet())
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int32(1), calls.Load())
+
+	// (2) Error caching
+
+	calls.Store(0)
+	fail := func() (int, error) {
+		if calls.Add(1) == 1 {
+			return 0, boom
+		}
+		return 1, nil
+	}
+
+	l = lang.NewLazy(fail)
+	_, err := l.Get()
+	assert.ErrorIs(t, err, boom)
+	_, err = l.Get()
+	assert.ErrorIs(t, err, boom)
+
+	calls.Store(0)
+	l = lang.NewLazyRetry(fail)
+	_, err = l.Get()
+	assert.ErrorIs(t, err, boom)
+	assert.Equal(t, 1, l.MustGet())
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestMemoize(t *testing.T) {
+	var calls []int
+	square := func(n int) int {
+		calls = append(calls, n)
+		return n * n
+	}
+
+	fn := lang.Memoize(square)
+	assert.Equal(t, 4, fn(2))
+	assert.Equal(t, 4, fn(2))
+	assert.Equal(t, 9, fn(3))
+	assert.Equal(t, []int{2, 3}, calls)
+
+	calls = nil
+	fn = lang.MemoizeN(square, 2)
+	fn(1)
+	fn(2)
+	fn(1)
+	fn(3) // evicts 2
+	fn(1)
+	fn(2)
+	assert.Equal(t, []int{1, 2, 3, 2}, calls)
+}
@@ -0,0 +1,297 @@
+package lang
+
+import (
+	"container/heap"
+	"errors"
+	"fmt"
+)
+
+// MergeSorted merges sorted iterators into a single sorted iterator lazily, using a heap. Equal
+// values are returned in iterator order.
+func MergeSorted[T any](cmp CompareFn[T], its ...Iterator[T]) Iterator[T] {
+	return &merged[T]{its: its, h: &mergeHeap[T]{cmp: cmp}}
+}
+
+type merged[T any] struct {
+	its  []Iterator[T]
+	h    *mergeHeap[T]
+	init bool
+}
+
+func (it *merged[T]) Next() (T, bool) {
+	if !it.init {
+		for i, src := range it.its {
+			if t, ok := src.Next(); ok {
+				it.h.list = append(it.h.list, mergeElm[T]{t: t, i: i})
+			}
+		}
+		heap.Init(it.h)
+		it.init = true
+	}
+
+	if len(it.h.list) == 0 {
+		var t T
+		return t, false
+	}
+	top := &it.h.list[0]
+	ret := top.t
+	if t, ok := it.its[top.i].Next(); ok {
+		top.t = t
+		heap.Fix(it.h, 0)
+	} else {
+		heap.Pop(it.h)
+	}
+	return ret, true
+}
+
+func (it *merged[T]) Err() error {
+	var errs []error
+	for _, src := range it.its {
+		errs = append(errs, AsStream(src).Err())
+	}
+	return errors.Join(errs...)
+}
+
+func (it *merged[T]) Close() error {
+	var errs []error
+	for _, src := range it.its {
+		errs = append(errs, AsStream(src).Close())
+	}
+	return errors.Join(errs...)
+}
+
+type mergeElm[T any] struct {
+	t T
+	i int // iterator index
+}
+
+type mergeHeap[T any] struct {
+	list []mergeElm[T]
+	cmp  CompareFn[T]
+}
+
+func (h *mergeHeap[T]) Len() int {
+	return len(h.list)
+}
+
+func (h *mergeHeap[T]) Less(i, j int) bool {
+	if c := h.cmp(h.list[i].t, h.list[j].t); c != 0 {
+		return c < 0
+	}
+	return h.list[i].i < h.list[j].i
+}
+
+func (h *mergeHeap[T]) Swap(i, j int) {
+	h.list[i], h.list[j] = h.list[j], h.list[i]
+}
+
+func (h *mergeHeap[T]) Push(x any) {
+	h.list = append(h.list, x.(mergeElm[T]))
+}
+
+func (h *mergeHeap[T]) Pop() any {
+	ret := h.list[len(h.list)-1]
+	h.list = h.list[:len(h.list)-1]
+	return ret
+}
+
+// JoinType is the type of merge join.
+type JoinType int
+
+const (
+	// InnerJoin returns matching pairs only.
+	InnerJoin JoinType = iota
+	// LeftJoin returns matching pairs and unmatched left values.
+	LeftJoin
+	// FullJoin returns matching pairs and unmatched left and right values.
+	FullJoin
+)
+
+func (j JoinType) String() string {
+	switch j {
+	case InnerJoin:
+		return "inner"
+	case LeftJoin:
+		return "left"
+	case FullJoin:
+		return "full"
+	default:
+		return fmt.Sprintf("unknown(%d)", int(j))
+	}
+}
+
+// Joined is a joined pair of values. Either side may be None for outer joins.
+type Joined[A, B any] struct {
+	Left  Optional[A]
+	Right Optional[B]
+}
+
+func (j Joined[A, B]) String() string {
+	return fmt.Sprintf("(%v, %v)", j.Left, j.Right)
+}
+
+// MergeJoin joins two iterators sorted by key lazily. Values with equal keys are joined pairwise,
+// in iterator order.
+func MergeJoin[A, B, K any](kind JoinType, cmp CompareFn[K], left Iterator[A], lkey func(A) K, right Iterator[B], rkey func(B) K) Iterator[Joined[A, B]] {
+	return &mergeJoin[A, B, K]{
+		kind:  kind,
+		cmp:   cmp,
+		left:  Peekable(left),
+		lkey:  lkey,
+		right: Peekable(right),
+		rkey:  rkey,
+	}
+}
+
+type mergeJoin[A, B, K any] struct {
+	kind  JoinType
+	cmp   CompareFn[K]
+	left  *PeekIterator[A]
+	lkey  func(A) K
+	right *PeekIterator[B]
+	rkey  func(B) K
+
+	buf []Joined[A, B]
+}
+
+func (it *mergeJoin[A, B, K]) Next() (Joined[A, B], bool) {
+	for len(it.buf) == 0 {
+		a, lok := it.left.Peek()
+		b, rok := it.right.Peek()
+
+		switch {
+		case lok && rok:
+			lk, rk := it.lkey(a), it.rkey(b)
+			c := it.cmp(lk, rk)
+			if c < 0 {
+				it.left.Next()
+				if it.kind != InnerJoin {
+					return Joined[A, B]{Left: Some(a)}, true
+				}
+				continue
+			}
+			if c > 0 {
+				it.right.Next()
+				if it.kind == FullJoin {
+					return Joined[A, B]{Right: Some(b)}, true
+				}
+				continue
+			}
+
+			as := takeKey(it.left, it.lkey, it.cmp, lk)
+			bs := takeKey(it.right, it.rkey, it.cmp, rk)
+			for _, a := range as {
+				for _, b := range bs {
+					it.buf = append(it.buf, Joined[A, B]{Left: Some(a), Right: Some(b)})
+				}
+			}
+
+		case lok && it.kind != InnerJoin:
+			it.left.Next()
+			return Joined[A, B]{Left: Some(a)}, true
+
+		case rok && it.kind == FullJoin:
+			it.right.Next()
+			return Joined[A, B]{Right: Some(b)}, true
+
+		default:
+			return Joined[A, B]{}, false
+		}
+	}
+
+	ret := it.buf[0]
+	it.buf = it.buf[1:]
+	return ret, true
+}
+
+func (it *mergeJoin[A, B, K]) Err() error {
+	return errors.Join(it.left.Err(), it.right.Err())
+}
+
+func (it *mergeJoin[A, B, K]) Close() error {
+	return errors.Join(it.left.Close(), it.right.Close())
+}
+
+// takeKey consumes all leading values with the given key.
+func takeKey[T, K any](it *PeekIterator[T], key func(T) K, cmp CompareFn[K], k K) []T {
+	var ret []T
+	for {
+		t, ok := it.Peek()
+		if !ok || cmp(key(t), k) != 0 {
+			return ret
+		}
+		it.Next()
+		ret = append(ret, t)
+	}
+}
+
+// Intersect returns the values of the sorted iterator a that are also in the sorted iterator b
+// lazily.
+func Intersect[T any](cmp CompareFn[T], a, b Iterator[T]) Iterator[T] {
+	return &intersect[T]{cmp: cmp, a: a, b: Peekable(b), keep: true}
+}
+
+// Except returns the values of the sorted iterator a that are not in the sorted iterator b lazily.
+func Except[T any](cmp CompareFn[T], a, b Iterator[T]) Iterator[T] {
+	return &intersect[T]{cmp: cmp, a: a, b: Peekable(b), keep: false}
+}
+
+type intersect[T any] struct {
+	cmp  CompareFn[T]
+	a    Iterator[T]
+	b    *PeekIterator[T]
+	keep bool // keep values in b?
+}
+
+func (it *intersect[T]) Next() (T, bool) {
+	for {
+		t, ok := it.a.Next()
+		if !ok {
+			return t, false
+		}
+
+		found := false
+		for {
+			u, ok := it.b.Peek()
+			if !ok {
+				if it.keep {
+					var t T
+					return t, false // b exhausted: no more matches
+				}
+				break
+			}
+			c := it.cmp(u, t)
+			if c < 0 {
+				it.b.Next()
+				continue
+			}
+			found = c == 0
+			break
+		}
+		if found == it.keep {
+			return t, true
+		}
+	}
+}
+
+func (it *intersect[T]) Err() error {
+	return errors.Join(AsStream(it.a).Err(), it.b.Err())
+}
+
+func (it *intersect[T]) Close() error {
+	return errors.Join(AsStream(it.a).Close(), it.b.Close())
+}
+
+// Dedup skips consecutive equal values lazily. For a sorted iterator, it returns distinct values.
+func Dedup[T any](cmp CompareFn[T], it Iterator[T]) Iterator[T] {
+	var last T
+	first := true
+	return Filter(it, func(t T) bool {
+		if !first && cmp(last, t) == 0 {
+			return false
+		}
+		first = false
+		last = t
+		return true
+	})
+}
@@ -0,0 +1,56 @@
+package lang_test
+
+import (
+	"github.com/seekerror/stdlib/pkg/lang"
+	"github.com/stretchr/testify/assert"
+	"slices"
+	"testing"
+)
+
+func ints(list ...int) lang.Iterator[int] {
+	it, _ := lang.FromSeq(slices.Values(list))
+	return it
+}
+
+func TestMergeSorted(t *testing.T) {
+	cmp := lang.Compare[int]
+
+	assert.Equal(t, []int{0, 1, 1, 2, 3, 4, 5, 8}, lang.ToList(lang.MergeSorted(cmp, ints(1, 4, 5), ints(), ints(0, 1, 8), ints(2, 3))))
+	assert.Equal(t, 0, len(lang.ToList(lang.MergeSorted[int](cmp))))
+
+	assert.Equal(t, []int{1, 3}, lang.ToList(lang.Intersect(cmp, ints(1, 2, 3, 5), ints(0, 1, 3, 4))))
+	assert.Equal(t, []int{2, 5}, lang.ToList(lang.Except(cmp, ints(1, 2, 3, 5), ints(0, 1, 3, 4))))
+	assert.Equal(t, []int{1, 2, 3}, lang.ToList(lang.Dedup(cmp, ints(1, 1, 2, 3, 3, 3))))
+}
+
+func TestMergeJoin(t *testing.T) {
+	type row struct {
+		id   int
+		name string
+	}
+	key := func(r row) int {
+		return r.id
+	}
+	left := func() lang.Iterator[row] {
+		it, _ := lang.FromSeq(slices.Values([]row{{1, "a"}, {2, "b"}, {2, "c"}, {4, "d"}}))
+		return it
+	}
+	right := func() lang.Iterator[int] {
+		return ints(0, 2, 2, 3, 4)
+	}
+
+	join := func(kind lang.JoinType) string {
+		return lang.Sprint(lang.Map(lang.MergeJoin(kind, lang.Compare[int], left(), key, right(), func(n int) int { return n }), func(j lang.Joined[row, int]) string {
+			l, _ := j.Left.V()
+			r, ok := j.Right.V()
+			if !ok {
+				r = -1
+			}
+			return l.name + ":" + string(rune('0'+r))
+		}))
+	}
+
+	assert.Equal(t, "[b:2, b:2, c:2, c:2, d:4]", join(lang.InnerJoin))
+	assert.Equal(t, "[a:/, b:2, b:2, c:2, c:2, d:4]", join(lang.LeftJoin))
+	assert.Equal(t, "[:0, a:/, b:2, b:2, c:2, c:2, :3, d:4]", join(lang.FullJoin))
+}
@@ -1,8 +1,16 @@
 package lang
 
-import "fmt"
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"fmt"
+)
 
-// Optional represents an optional value of type T. Default value is None.
+// Optional represents an optional value of type T. Default value is None. It encodes None as
+// null in JSON and SQL, and as the empty string in text.
 type Optional[T any] struct {
 	t  T
 	ok bool
@@ -16,14 +24,170 @@ func None[T any]() Optional[T] {
 	return Optional[T]{}
 }
 
+// FromPtr returns Some of the pointed-to value, or None if the pointer is nil.
+func FromPtr[T any](p *T) Optional[T] {
+	if p == nil {
+		return None[T]()
+	}
+	return Some(*p)
+}
+
+// MapOptional transforms the value, if present.
+func MapOptional[T, U any](o Optional[T], fn func(t T) U) Optional[U] {
+	if !o.ok {
+		return None[U]()
+	}
+	return Some(fn(o.t))
+}
+
+// FlatMapOptional transforms the value into an optional value, if present.
+func FlatMapOptional[T, U any](o Optional[T], fn func(t T) Optional[U]) Optional[U] {
+	if !o.ok {
+		return None[U]()
+	}
+	return fn(o.t)
+}
+
 // V returns the value. False if not present.
 func (o Optional[T]) V() (T, bool) {
 	return o.t, o.ok
 }
 
+// IsSome returns true iff the value is present.
+func (o Optional[T]) IsSome() bool {
+	return o.ok
+}
+
+// IsNone returns true iff the value is not present.
+func (o Optional[T]) IsNone() bool {
+	return !o.ok
+}
+
+// IsZero returns true iff the value is not present. It supports the "omitzero" JSON option.
+func (o Optional[T]) IsZero() bool {
+	return !o.ok
+}
+
+// MustGet returns the value. Panics if not present.
+func (o Optional[T]) MustGet() T {
+	if !o.ok {
+		panic("optional value not present")
+	}
+	return o.t
+}
+
+// OrElse returns the value, if present. Otherwise, it returns the given value.
+func (o Optional[T]) OrElse(t T) T {
+	if !o.ok {
+		return t
+	}
+	return o.t
+}
+
+// OrElseGet returns the value, if present. Otherwise, it returns the result of the given function.
+func (o Optional[T]) OrElseGet(fn func() T) T {
+	if !o.ok {
+		return fn()
+	}
+	return o.t
+}
+
+// Filter returns the optional value, if present and satisfying the predicate. Otherwise, None.
+func (o Optional[T]) Filter(fn func(t T) bool) Optional[T] {
+	if !o.ok || !fn(o.t) {
+		return None[T]()
+	}
+	return o
+}
+
+// Ptr returns a pointer to a copy of the value, or nil if not present.
+func (o Optional[T]) Ptr() *T {
+	if !o.ok {
+		return nil
+	}
+	t := o.t
+	return &t
+}
+
 func (o Optional[T]) String() string {
 	if !o.ok {
 		return "none"
 	}
 	return fmt.Sprintf("some(%v)", o.t)
 }
+
+func (o Optional[T]) MarshalJSON() ([]byte, error) {
+	if !o.ok {
+		return []byte("null"), nil
+	}
+	return json.Marshal(o.t)
+}
+
+func (o *Optional[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		*o = None[T]()
+		return nil
+	}
+	var t T
+	if err := json.Unmarshal(data, &t); err != nil {
+		return err
+	}
+	*o = Some(t)
+	return nil
+}
+
+// MarshalText encodes the value using its encoding.TextMarshaler, if implemented, or its default
+// format. None is encoded as the empty string.
+func (o Optional[T]) MarshalText() ([]byte, error) {
+	if !o.ok {
+		return nil, nil
+	}
+	switch t := any(o.t).(type) {
+	case encoding.TextMarshaler:
+		return t.MarshalText()
+	case string:
+		return []byte(t), nil
+	default:
+		return []byte(fmt.Sprint(t)), nil
+	}
+}
+
+// UnmarshalText decodes the value using its encoding.TextUnmarshaler, if implemented, or by
+// scanning its default format. The empty string is decoded as None.
+func (o *Optional[T]) UnmarshalText(data []byte) error {
+	if len(data) == 0 {
+		*o = None[T]()
+		return nil
+	}
+
+	var t T
+	switch p := any(&t).(type) {
+	case encoding.TextUnmarshaler:
+		if err := p.UnmarshalText(data); err != nil {
+			return err
+		}
+	case *string:
+		*p = string(data)
+	default:
+		if _, err := fmt.Sscan(string(data), p); err != nil {
+			return fmt.Errorf("failed to parse '%v': %w", string(data), err)
+		}
+	}
+	*o = Some(t)
+	return nil
+}
+
+// Scan implements sql.Scanner. SQL NULL is decoded as None.
+func (o *Optional[T]) Scan(src any) error {
+	var n sql.Null[T]
+	if err := n.Scan(src); err != nil {
+		return err
+	}
+	*o = Optional[T]{t: n.V, ok: n.Valid}
+	return nil
+}
+
+// Value implements driver.Valuer. None is encoded as SQL NULL.
+func (o Optional[T]) Value() (driver.Value, error) {
+	return sql.Null[T]{V: o.t, Valid: o.ok}.Value()
+}
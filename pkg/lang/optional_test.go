@@ -0,0 +1,87 @@
+package lang_test
+
+import (
+	"encoding/json"
+	"github.com/seekerror/stdlib/pkg/lang"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestOptional(t *testing.T) {
+	some, none := lang.Some(2), lang.None[int]()
+
+	assert.True(t, some.IsSome())
+	assert.True(t, none.IsNone())
+	assert.Equal(t, 2, some.MustGet())
+	assert.Panics(t, func() { none.MustGet() })
+	assert.Equal(t, 2, some.OrElse(3))
+	assert.Equal(t, 3, none.OrElse(3))
+	assert.Equal(t, 4, none.OrElseGet(func() int { return 4 }))
+
+	assert.Equal(t, lang.Some("2"), lang.MapOptional(some, strconv.Itoa))
+	assert.Equal(t, lang.None[string](), lang.MapOptional(none, strconv.Itoa))
+	assert.Equal(t, none, lang.FlatMapOptional(some, func(n int) lang.Optional[int] {
+		return lang.None[int]()
+	}))
+	assert.Equal(t, some, some.Filter(func(n int) bool { return n > 1 }))
+	assert.Equal(t, none, some.Filter(func(n int) bool { return n > 2 }))
+
+	assert.Nil(t, none.Ptr())
+	assert.Equal(t, 2, *some.Ptr())
+	assert.Equal(t, some, lang.FromPtr(some.Ptr()))
+	assert.Equal(t, none, lang.FromPtr[int](nil))
+}
+
+func TestOptionalEncoding(t *testing.T) {
+	type row struct {
+		A lang.Optional[int]       `json:"a"`
+		B lang.Optional[string]    `json:"b"`
+		C lang.Optional[time.Time] `json:"c,omitempty"`
+	}
+
+	// (1) JSON
+
+	data, err := json.Marshal(row{A: lang.Some(1)})
+	require.NoError(t, err)
+	assert.Equal(t, `{"a":1,"b":null,"c":null}`, string(data))
+
+	var r row
+	require.NoError(t, json.Unmarshal([]byte(`{"a":null,"b":"x"}`), &r))
+	assert.Equal(t, row{B: lang.Some("x")}, r)
+	assert.Error(t, json.Unmarshal([]byte(`{"a":"x"}`), &r))
+
+	// (2) Text
+
+	ts := time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC)
+	data, err = json.Marshal(map[lang.Optional[int]]lang.Optional[time.Time]{lang.Some(3): lang.Some(ts)})
+	require.NoError(t, err)
+	assert.Equal(t, `{"3":"2022-06-01T00:00:00Z"}`, string(data))
+
+	var o lang.Optional[int]
+	require.NoError(t, o.UnmarshalText([]byte("42")))
+	assert.Equal(t, lang.Some(42), o)
+	require.NoError(t, o.UnmarshalText(nil))
+	assert.Equal(t, lang.None[int](), o)
+	assert.Error(t, o.UnmarshalText([]byte("x")))
+
+	var ot lang.Optional[time.Time]
+	require.NoError(t, ot.UnmarshalText([]byte("2022-06-01T00:00:00Z")))
+	assert.Equal(t, lang.Some(ts), ot)
+
+	// (3) SQL
+
+	v, err := lang.Some(int64(5)).Value()
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), v)
+	v, err = lang.None[int64]().Value()
+	require.NoError(t, err)
+	assert.Nil(t, v)
+
+	require.NoError(t, o.Scan(int64(7)))
+	assert.Equal(t, lang.Some(7), o)
+	require.NoError(t, o.Scan(nil))
+	assert.Equal(t, lang.None[int](), o)
+}
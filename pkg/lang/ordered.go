@@ -1,7 +1,12 @@
 // Package lang provides extensions and utilities to builtin language features.
 package lang
 
-import "golang.org/x/exp/constraints"
+import (
+	"golang.org/x/exp/constraints"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
 
 // EqualsFn defines equality for arbitrary types. This function is not a method to avoid a boxing
 // penalty for every natively-ordered object in a data structure.
@@ -31,3 +36,136 @@ func Reverse[T any](fn CompareFn[T]) CompareFn[T] {
 		return fn(b, a)
 	}
 }
+
+// Comparing orders values by the given key.
+func Comparing[T, K any](key func(t T) K, cmp CompareFn[K]) CompareFn[T] {
+	return func(a, b T) int {
+		return cmp(key(a), key(b))
+	}
+}
+
+// ThenComparing orders values lexicographically by the given orderings, i.e., by the first
+// ordering, then by the second ordering for ties and so on.
+func ThenComparing[T any](fns ...CompareFn[T]) CompareFn[T] {
+	return func(a, b T) int {
+		for _, fn := range fns {
+			if c := fn(a, b); c != 0 {
+				return c
+			}
+		}
+		return 0
+	}
+}
+
+// NilsFirst orders pointers by the pointed-to values, with nil before any value.
+func NilsFirst[T any](fn CompareFn[T]) CompareFn[*T] {
+	return func(a, b *T) int {
+		switch {
+		case a == nil && b == nil:
+			return 0
+		case a == nil:
+			return -1
+		case b == nil:
+			return 1
+		default:
+			return fn(*a, *b)
+		}
+	}
+}
+
+// NilsLast orders pointers by the pointed-to values, with nil after any value.
+func NilsLast[T any](fn CompareFn[T]) CompareFn[*T] {
+	first := NilsFirst(fn)
+	return func(a, b *T) int {
+		if (a == nil) != (b == nil) {
+			return -first(a, b)
+		}
+		return first(a, b)
+	}
+}
+
+// CompareSlices orders slices lexicographically, with a proper prefix before the longer slice.
+func CompareSlices[T any](fn CompareFn[T]) CompareFn[[]T] {
+	return func(a, b []T) int {
+		for i := 0; i < len(a) && i < len(b); i++ {
+			if c := fn(a[i], b[i]); c != 0 {
+				return c
+			}
+		}
+		return Compare(len(a), len(b))
+	}
+}
+
+// CompareOptional orders optional values by their values, with None before any value.
+func CompareOptional[T any](fn CompareFn[T]) CompareFn[Optional[T]] {
+	return func(a, b Optional[T]) int {
+		switch {
+		case !a.ok && !b.ok:
+			return 0
+		case !a.ok:
+			return -1
+		case !b.ok:
+			return 1
+		default:
+			return fn(a.t, b.t)
+		}
+	}
+}
+
+// CompareFold orders strings case-insensitively, by comparing their lower-case runes.
+func CompareFold(a, b string) int {
+	for a != "" && b != "" {
+		ra, na := utf8.DecodeRuneInString(a)
+		rb, nb := utf8.DecodeRuneInString(b)
+		if c := Compare(unicode.ToLower(ra), unicode.ToLower(rb)); c != 0 {
+			return c
+		}
+		a, b = a[na:], b[nb:]
+	}
+	return Compare(len(a), len(b))
+}
+
+// CompareNatural orders strings in natural order, where runs of decimal digits are compared
+// numerically, such that "a2" is before "a10". Strings that only differ in leading zeros are
+// ordered bytewise for a total order.
+func CompareNatural(a, b string) int {
+	x, y := a, b
+	for x != "" && y != "" {
+		if isDigit(x[0]) && isDigit(y[0]) {
+			dx, dy := digits(x), digits(y)
+			nx, ny := strings.TrimLeft(dx, "0"), strings.TrimLeft(dy, "0")
+			if c := Compare(len(nx), len(ny)); c != 0 {
+				return c
+			}
+			if c := strings.Compare(nx, ny); c != 0 {
+				return c
+			}
+			x, y = x[len(dx):], y[len(dy):]
+			continue
+		}
+
+		rx, n := utf8.DecodeRuneInString(x)
+		ry, m := utf8.DecodeRuneInString(y)
+		if c := Compare(rx, ry); c != 0 {
+			return c
+		}
+		x, y = x[n:], y[m:]
+	}
+	if c := Compare(len(x), len(y)); c != 0 {
+		return c
+	}
+	return strings.Compare(a, b)
+}
+
+func isDigit(b byte) bool {
+	return '0' <= b && b <= '9'
+}
+
+// digits returns the leading run of decimal digits.
+func digits(s string) string {
+	i := 0
+	for i < len(s) && isDigit(s[i]) {
+		i++
+	}
+	return s[:i]
+}
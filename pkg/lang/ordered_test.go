@@ -0,0 +1,47 @@
+package lang_test
+
+import (
+	"github.com/seekerror/stdlib/pkg/lang"
+	"github.com/seekerror/stdlib/pkg/util/sortx"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestCompareBuilders(t *testing.T) {
+	type person struct {
+		name string
+		age  int
+	}
+	people := []person{{"b", 30}, {"a", 40}, {"c", 30}}
+
+	sortx.SortT(people, lang.ThenComparing(
+		lang.Comparing(func(p person) int { return p.age }, lang.Compare[int]),
+		lang.Comparing(func(p person) string { return p.name }, lang.Reverse(lang.Compare[string])),
+	))
+	assert.Equal(t, []person{{"c", 30}, {"b", 30}, {"a", 40}}, people)
+
+	one, two := 1, 2
+	ptrs := []*int{&two, nil, &one}
+	sortx.SortT(ptrs, lang.NilsFirst(lang.Compare[int]))
+	assert.Equal(t, []*int{nil, &one, &two}, ptrs)
+	sortx.SortT(ptrs, lang.NilsLast(lang.Compare[int]))
+	assert.Equal(t, []*int{&one, &two, nil}, ptrs)
+
+	slices := [][]int{{1, 2}, {1}, {0, 5}, {}}
+	sortx.SortT(slices, lang.CompareSlices(lang.Compare[int]))
+	assert.Equal(t, [][]int{{}, {0, 5}, {1}, {1, 2}}, slices)
+
+	opts := []lang.Optional[int]{lang.Some(2), lang.None[int](), lang.Some(1)}
+	sortx.SortT(opts, lang.CompareOptional(lang.Compare[int]))
+	assert.Equal(t, []lang.Optional[int]{lang.None[int](), lang.Some(1), lang.Some(2)}, opts)
+}
+
+func TestCompareStrings(t *testing.T) {
+	assert.Equal(t, 0, lang.CompareFold("Hello", "hELLO"))
+	assert.Equal(t, -1, lang.CompareFold("apple", "Banana"))
+	assert.Equal(t, 1, lang.CompareFold("abc", "AB"))
+
+	files := []string{"file10.txt", "file2.txt", "File1.txt", "file02.txt", "file1.txt", "file"}
+	sortx.SortT(files, lang.CompareNatural)
+	assert.Equal(t, []string{"File1.txt", "file", "file1.txt", "file02.txt", "file2.txt", "file10.txt"}, files)
+}
@@ -0,0 +1,90 @@
+package lang
+
+// Peekable returns an iterator that allows looking at the next value without consuming it.
+func Peekable[T any](it Iterator[T]) *PeekIterator[T] {
+	if p, ok := it.(*PeekIterator[T]); ok {
+		return p
+	}
+	return &PeekIterator[T]{upstream: upstream[T]{it: it}}
+}
+
+// PeekIterator is an iterator with a one-value lookahead.
+type PeekIterator[T any] struct {
+	upstream[T]
+	next   T
+	peeked bool
+	ok     bool
+}
+
+// Peek returns the next value without consuming it. False if exhausted.
+func (it *PeekIterator[T]) Peek() (T, bool) {
+	if !it.peeked {
+		it.next, it.ok = it.it.Next()
+		it.peeked = true
+	}
+	return it.next, it.ok
+}
+
+func (it *PeekIterator[T]) Next() (T, bool) {
+	if it.peeked {
+		it.peeked = false
+		ret := it.next
+		var t T
+		it.next = t
+		return ret, it.ok
+	}
+	return it.it.Next()
+}
+
+// Rewindable returns an iterator that allows re-reading values from a marked position.
+func Rewindable[T any](it Iterator[T]) *RewindIterator[T] {
+	return &RewindIterator[T]{upstream: upstream[T]{it: it}}
+}
+
+// RewindIterator is an iterator that buffers values after a mark, so that they can be re-read.
+// Values are not buffered if there is no mark.
+type RewindIterator[T any] struct {
+	upstream[T]
+	buf    []T
+	pos    int // position in buf of the next value
+	marked bool
+}
+
+// Mark marks the current position. Any prior mark is discarded.
+func (it *RewindIterator[T]) Mark() {
+	it.buf = it.buf[it.pos:]
+	it.pos = 0
+	it.marked = true
+}
+
+// Unmark discards the mark, if any. Buffered values not yet re-read are retained.
+func (it *RewindIterator[T]) Unmark() {
+	it.buf = it.buf[it.pos:]
+	it.pos = 0
+	it.marked = false
+}
+
+// Reset rewinds to the marked position. It does nothing if there is no mark. The mark is retained.
+func (it *RewindIterator[T]) Reset() {
+	if it.marked {
+		it.pos = 0
+	}
+}
+
+func (it *RewindIterator[T]) Next() (T, bool) {
+	if it.pos < len(it.buf) {
+		ret := it.buf[it.pos]
+		it.pos++
+		if !it.marked && it.pos == len(it.buf) {
+			it.buf, it.pos = nil, 0
+		}
+		return ret, true
+	}
+
+	t, ok := it.it.Next()
+	if ok && it.marked {
+		it.buf = append(it.buf, t)
+		it.pos++
+	}
+	return t, ok
+}
@@ -0,0 +1,52 @@
+package lang_test
+
+import (
+	"github.com/seekerror/stdlib/pkg/lang"
+	"github.com/seekerror/stdlib/pkg/util/mathx"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestPeekable(t *testing.T) {
+	it := lang.Peekable(lang.Head(mathx.Numbers(0), 2))
+
+	v, ok := it.Peek()
+	assert.True(t, ok)
+	assert.Equal(t, 0, v)
+	v, _ = it.Peek()
+	assert.Equal(t, 0, v)
+	v, _ = it.Next()
+	assert.Equal(t, 0, v)
+	v, _ = it.Next()
+	assert.Equal(t, 1, v)
+
+	_, ok = it.Peek()
+	assert.False(t, ok)
+	_, ok = it.Next()
+	assert.False(t, ok)
+}
+
+func TestRewindable(t *testing.T) {
+	it := lang.Rewindable(lang.Head(mathx.Numbers(0), 6))
+
+	it.Reset() // no mark: no-op
+	v, _ := it.Next()
+	assert.Equal(t, 0, v)
+
+	it.Mark()
+	assert.Equal(t, []int{1, 2}, lang.ToList(lang.Head[int](it, 2)))
+	it.Reset()
+	assert.Equal(t, []int{1, 2, 3}, lang.ToList(lang.Head[int](it, 3)))
+	it.Reset()
+	v, _ = it.Next()
+	assert.Equal(t, 1, v)
+
+	it.Mark()
+	it.Next()
+	it.Reset()
+	it.Unmark()
+	assert.Equal(t, []int{2, 3, 4, 5}, lang.ToList[int](it))
+	it.Reset()
+	_, ok := it.Next()
+	assert.False(t, ok)
+}
@@ -0,0 +1,89 @@
+package lang
+
+import "fmt"
+
+// Result represents either a value of type T or an error. Default value is Ok of the zero value.
+type Result[T any] struct {
+	t   T
+	err error
+}
+
+// Ok returns a successful result.
+func Ok[T any](t T) Result[T] {
+	return Result[T]{t: t}
+}
+
+// Err returns a failed result. The error must not be nil.
+func Err[T any](err error) Result[T] {
+	return Result[T]{err: err}
+}
+
+// NewResult returns a result from a (T, error) pair. Convenience function.
+func NewResult[T any](t T, err error) Result[T] {
+	if err != nil {
+		return Err[T](err)
+	}
+	return Ok(t)
+}
+
+// MapResult transforms the value, if successful.
+func MapResult[T, U any](r Result[T], fn func(t T) U) Result[U] {
+	if r.err != nil {
+		return Err[U](r.err)
+	}
+	return Ok(fn(r.t))
+}
+
+// AndThen transforms the value with a fallible function, if successful.
+func AndThen[T, U any](r Result[T], fn func(t T) (U, error)) Result[U] {
+	if r.err != nil {
+		return Err[U](r.err)
+	}
+	return NewResult(fn(r.t))
+}
+
+// CollectResults materializes all iterator values into a list. It stops at the first failed
+// result and returns its error.
+func CollectResults[T any](it Iterator[Result[T]]) ([]T, error) {
+	var ret []T
+	for {
+		r, ok := it.Next()
+		if !ok {
+			return ret, nil
+		}
+		if r.err != nil {
+			return ret, r.err
+		}
+		ret = append(ret, r.t)
+	}
+}
+
+// Get returns the value or error.
+func (r Result[T]) Get() (T, error) {
+	return r.t, r.err
+}
+
+// IsOk returns true iff successful.
+func (r Result[T]) IsOk() bool {
+	return r.err == nil
+}
+
+// Err returns the error, if failed. Nil if successful.
+func (r Result[T]) Err() error {
+	return r.err
+}
+
+// Unwrap returns the value. Panics with the error if failed.
+func (r Result[T]) Unwrap() T {
+	if r.err != nil {
+		panic(fmt.Sprintf("unwrap of failed result: %v", r.err))
+	}
+	return r.t
+}
+
+func (r Result[T]) String() string {
+	if r.err != nil {
+		return fmt.Sprintf("err(%v)", r.err)
+	}
+	return fmt.Sprintf("ok(%v)", r.t)
+}
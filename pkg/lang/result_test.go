@@ -0,0 +1,38 @@
+package lang_test
+
+import (
+	"errors"
+	"github.com/seekerror/stdlib/pkg/lang"
+	"github.com/stretchr/testify/assert"
+	"strconv"
+	"testing"
+)
+
+func TestResult(t *testing.T) {
+	boom := errors.New("boom")
+
+	ok, failed := lang.Ok(2), lang.Err[int](boom)
+	assert.True(t, ok.IsOk())
+	assert.False(t, failed.IsOk())
+	assert.Equal(t, 2, ok.Unwrap())
+	assert.Panics(t, func() { failed.Unwrap() })
+
+	v, err := lang.MapResult(ok, strconv.Itoa).Get()
+	assert.NoError(t, err)
+	assert.Equal(t, "2", v)
+	assert.ErrorIs(t, lang.MapResult(failed, strconv.Itoa).Err(), boom)
+
+	n, err := lang.AndThen(lang.Ok("x"), strconv.Atoi).Get()
+	assert.Error(t, err)
+	assert.Equal(t, 0, n)
+	assert.Equal(t, "ok(12)", lang.AndThen(lang.Ok("12"), strconv.Atoi).String())
+
+	list, err := lang.CollectResults(lang.Map(ints(1, 2, 3), func(n int) lang.Result[int] {
+		if n == 3 {
+			return lang.Err[int](boom)
+		}
+		return lang.Ok(n)
+	}))
+	assert.ErrorIs(t, err, boom)
+	assert.Equal(t, []int{1, 2}, list)
+}
@@ -0,0 +1,52 @@
+package lang
+
+import "iter"
+
+// Seq returns a single-use range-over-func sequence of the iterator values. Ranging over it
+// consumes the iterator.
+func Seq[T any](it Iterator[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for {
+			t, ok := it.Next()
+			if !ok || !yield(t) {
+				return
+			}
+		}
+	}
+}
+
+// FromSeq returns an iterator over the sequence values, which are computed lazily using iter.Pull.
+// The returned stop function must be called if the iterator is not exhausted to release its
+// resources. It is idempotent. The iterator is also a Stream, whose Close calls stop.
+func FromSeq[T any](seq iter.Seq[T]) (Iterator[T], func()) {
+	ret := newPulled(seq)
+	return ret, ret.stop
+}
+
+// newPulled returns a stream over the sequence values using iter.Pull.
+func newPulled[T any](seq iter.Seq[T]) *pulled[T] {
+	next, stop := iter.Pull(seq)
+	return &pulled[T]{next: next, stop: stop}
+}
+
+type pulled[T any] struct {
+	next func() (T, bool)
+	stop func()
+}
+
+func (it *pulled[T]) Next() (T, bool) {
+	t, ok := it.next()
+	if !ok {
+		it.stop()
+	}
+	return t, ok
+}
+
+func (it *pulled[T]) Err() error {
+	return nil
+}
+
+func (it *pulled[T]) Close() error {
+	it.stop()
+	return nil
+}
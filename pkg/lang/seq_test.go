@@ -0,0 +1,30 @@
+package lang_test
+
+import (
+	"github.com/seekerror/stdlib/pkg/lang"
+	"github.com/seekerror/stdlib/pkg/util/mathx"
+	"github.com/stretchr/testify/assert"
+	"slices"
+	"testing"
+)
+
+func TestSeq(t *testing.T) {
+	assert.Equal(t, []int{0, 1, 2}, slices.Collect(lang.Seq(lang.Head(mathx.Numbers(0), 3))))
+
+	it, stop := lang.FromSeq(slices.Values([]int{1, 2, 3}))
+	defer stop()
+	assert.Equal(t, []int{1, 2, 3}, lang.ToList(it))
+
+	// Early termination
+
+	done := false
+	it, stop = lang.FromSeq(func(yield func(int) bool) {
+		defer func() { done = true }()
+		for i := 0; yield(i); i++ {
+		}
+	})
+	assert.Equal(t, []int{0, 1}, lang.ToList(lang.Head(it, 2)))
+	assert.False(t, done)
+	stop()
+	assert.True(t, done)
+}
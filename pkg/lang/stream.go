@@ -0,0 +1,102 @@
+package lang
+
+import "errors"
+
+// Stream is an Iterator over a fallible or resource-backed source, such as file lines, database rows
+// or network pages. Once Next returns false, Err reports whether the iteration failed. Close must be
+// called if the stream is not exhausted. Iterator wrappers, such as Map and Head, are streams that
+// forward Err and Close to the wrapped iterator.
+type Stream[T any] interface {
+	Iterator[T]
+
+	// Err returns the error that terminated the iteration, if any.
+	Err() error
+	// Close releases any underlying resources. Idempotent.
+	Close() error
+}
+
+// AsStream returns the iterator as a stream. If not already a stream, it never fails and Close is
+// a no-op.
+func AsStream[T any](it Iterator[T]) Stream[T] {
+	if s, ok := it.(Stream[T]); ok {
+		return s
+	}
+	return &stream[T]{upstream: upstream[T]{it: it}}
+}
+
+type stream[T any] struct {
+	upstream[T]
+}
+
+func (s *stream[T]) Next() (T, bool) {
+	return s.it.Next()
+}
+
+// NewStream returns a stream from a fallible function, which returns a value, false if exhausted,
+// or an error. The iteration ends at the first error. The close function is called at most once
+// and may be nil.
+func NewStream[T any](next func() (T, bool, error), close func() error) Stream[T] {
+	return &fnStream[T]{next: next, close: close}
+}
+
+type fnStream[T any] struct {
+	next   func() (T, bool, error)
+	close  func() error
+	err    error
+	done   bool
+	closed bool
+}
+
+func (s *fnStream[T]) Next() (T, bool) {
+	if !s.done {
+		t, ok, err := s.next()
+		if err == nil && ok {
+			return t, true
+		}
+		s.err = err
+		s.done = true
+	}
+	var t T
+	return t, false
+}
+
+func (s *fnStream[T]) Err() error {
+	return s.err
+}
+
+func (s *fnStream[T]) Close() error {
+	s.done = true
+	if s.closed || s.close == nil {
+		return nil
+	}
+	s.closed = true
+	return s.close()
+}
+
+// ToListErr materializes all iterator values into a list and closes it. Returns the iteration or
+// close error, if the iterator is a stream.
+func ToListErr[T any](it Iterator[T]) ([]T, error) {
+	s := AsStream(it)
+	ret := ToList[T](s)
+	return ret, errors.Join(s.Err(), s.Close())
+}
+
+// upstream forwards Err and Close to the wrapped iterator, if a stream. It is embedded in iterator
+// wrappers to make them streams.
+type upstream[T any] struct {
+	it Iterator[T]
+}
+
+func (u upstream[T]) Err() error {
+	if s, ok := u.it.(Stream[T]); ok {
+		return s.Err()
+	}
+	return nil
+}
+
+func (u upstream[T]) Close() error {
+	if s, ok := u.it.(Stream[T]); ok {
+		return s.Close()
+	}
+	return nil
+}
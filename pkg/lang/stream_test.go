@@ -0,0 +1,63 @@
+package lang_test
+
+import (
+	"errors"
+	"github.com/seekerror/stdlib/pkg/lang"
+	"github.com/seekerror/stdlib/pkg/util/mathx"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+// failing returns a stream of 0, 1, .., n-1 that then fails. It records whether it was closed.
+func failing(n int, err error, closed *bool) lang.Stream[int] {
+	i := 0
+	return lang.NewStream(func() (int, bool, error) {
+		if i < n {
+			i++
+			return i - 1, true, nil
+		}
+		return 0, false, err
+	}, func() error {
+		*closed = true
+		return nil
+	})
+}
+
+func TestStream(t *testing.T) {
+	boom := errors.New("boom")
+
+	// (1) Errors are carried through wrappers
+
+	var closed bool
+	list, err := lang.ToListErr(lang.Map(lang.Filter[int](failing(5, boom, &closed), func(n int) bool {
+		return n%2 == 0
+	}), func(n int) int {
+		return n * 10
+	}))
+	assert.Equal(t, []int{0, 20, 40}, list)
+	assert.ErrorIs(t, err, boom)
+	assert.True(t, closed)
+
+	// (2) Close is carried through wrappers, even if not exhausted
+
+	closed = false
+	list, err = lang.ToListErr(lang.Head[int](failing(5, boom, &closed), 2))
+	assert.Equal(t, []int{0, 1}, list)
+	assert.NoError(t, err)
+	assert.True(t, closed)
+
+	// (3) Concat stops at the first failure
+
+	var closed2 bool
+	list, err = lang.ToListErr(lang.Concat[int](failing(1, boom, &closed), failing(1, nil, &closed2)))
+	assert.Equal(t, []int{0}, list)
+	assert.ErrorIs(t, err, boom)
+	assert.True(t, closed2)
+
+	// (4) Plain iterators never fail
+
+	list, err = lang.ToListErr(lang.Head(mathx.Numbers(0), 2))
+	assert.Equal(t, []int{0, 1}, list)
+	assert.NoError(t, err)
+	assert.NoError(t, lang.AsStream(mathx.Numbers(0)).Close())
+}
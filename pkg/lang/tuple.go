@@ -0,0 +1,88 @@
+package lang
+
+import "fmt"
+
+// Pair is a 2-tuple.
+type Pair[A, B any] struct {
+	A A
+	B B
+}
+
+func NewPair[A, B any](a A, b B) Pair[A, B] {
+	return Pair[A, B]{A: a, B: b}
+}
+
+func (p Pair[A, B]) String() string {
+	return fmt.Sprintf("(%v, %v)", p.A, p.B)
+}
+
+// ComparePair orders pairs lexicographically by the given component orderings.
+func ComparePair[A, B any](a CompareFn[A], b CompareFn[B]) CompareFn[Pair[A, B]] {
+	return func(x, y Pair[A, B]) int {
+		if c := a(x.A, y.A); c != 0 {
+			return c
+		}
+		return b(x.B, y.B)
+	}
+}
+
+// EqualsPair defines pair equality by the given component equality functions.
+func EqualsPair[A, B any](a EqualsFn[A], b EqualsFn[B]) EqualsFn[Pair[A, B]] {
+	return func(x, y Pair[A, B]) bool {
+		return a(x.A, y.A) && b(x.B, y.B)
+	}
+}
+
+// Triple is a 3-tuple.
+type Triple[A, B, C any] struct {
+	A A
+	B B
+	C C
+}
+
+func NewTriple[A, B, C any](a A, b B, c C) Triple[A, B, C] {
+	return Triple[A, B, C]{A: a, B: b, C: c}
+}
+
+func (t Triple[A, B, C]) String() string {
+	return fmt.Sprintf("(%v, %v, %v)", t.A, t.B, t.C)
+}
+
+// CompareTriple orders triples lexicographically by the given component orderings.
+func CompareTriple[A, B, C any](a CompareFn[A], b CompareFn[B], c CompareFn[C]) CompareFn[Triple[A, B, C]] {
+	return func(x, y Triple[A, B, C]) int {
+		if r := a(x.A, y.A); r != 0 {
+			return r
+		}
+		if r := b(x.B, y.B); r != 0 {
+			return r
+		}
+		return c(x.C, y.C)
+	}
+}
+
+// EqualsTriple defines triple equality by the given component equality functions.
+func EqualsTriple[A, B, C any](a EqualsFn[A], b EqualsFn[B], c EqualsFn[C]) EqualsFn[Triple[A, B, C]] {
+	return func(x, y Triple[A, B, C]) bool {
+		return a(x.A, y.A) && b(x.B, y.B) && c(x.C, y.C)
+	}
+}
+
+// Zip pairs the values of two iterators lazily. It stops when either iterator is exhausted.
+func Zip[A, B any](a Iterator[A], b Iterator[B]) Iterator[Pair[A, B]] {
+	return ZipWith(a, b, NewPair[A, B])
+}
+
+// Unzip materializes all iterator pairs into two lists.
+func Unzip[A, B any](it Iterator[Pair[A, B]]) ([]A, []B) {
+	var as []A
+	var bs []B
+	for {
+		p, ok := it.Next()
+		if !ok {
+			return as, bs
+		}
+		as = append(as, p.A)
+		bs = append(bs, p.B)
+	}
+}
@@ -0,0 +1,27 @@
+package lang_test
+
+import (
+	"github.com/seekerror/stdlib/pkg/container/redgreen"
+	"github.com/seekerror/stdlib/pkg/lang"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestTuple(t *testing.T) {
+	cmp := lang.ComparePair(lang.Compare[string], lang.Reverse(lang.Compare[int]))
+
+	rgt := redgreen.NewT[lang.Pair[string, int], bool](cmp)
+	for _, p := range []lang.Pair[string, int]{{"b", 1}, {"a", 1}, {"a", 2}} {
+		rgt.Insert(p, true)
+	}
+	assert.Equal(t, "[(a, 2):true, (a, 1):true, (b, 1):true]", rgt.String())
+
+	eq := lang.EqualsTriple(lang.Equals[int], lang.Equals[int], lang.Equals[string])
+	assert.True(t, eq(lang.NewTriple(1, 2, "a"), lang.NewTriple(1, 2, "a")))
+	assert.False(t, eq(lang.NewTriple(1, 2, "a"), lang.NewTriple(1, 2, "b")))
+	assert.Equal(t, -1, lang.CompareTriple(lang.Compare[int], lang.Compare[int], lang.Compare[string])(lang.NewTriple(1, 2, "a"), lang.NewTriple(1, 2, "b")))
+
+	as, bs := lang.Unzip(lang.Zip(ints(1, 2, 3), ints(4, 5)))
+	assert.Equal(t, []int{1, 2}, as)
+	assert.Equal(t, []int{4, 5}, bs)
+}
@@ -0,0 +1,74 @@
+package chanx
+
+import (
+	"fmt"
+	"time"
+)
+
+// Batch groups the values of a chan into batches of at most maxSize values. A batch is emitted
+// when full, when maxWait has passed since its first value was received, or when the input is
+// closed. If maxWait is not positive, batches are emitted only when full or at close. The output
+// is closed after the final batch, or when quit is closed, in which case any pending batch is
+// discarded.
+func Batch[T any](quit <-chan struct{}, in <-chan T, maxSize int, maxWait time.Duration) <-chan []T {
+	if maxSize < 1 {
+		panic(fmt.Sprintf("invalid batch size: %v", maxSize))
+	}
+
+	out := make(chan []T, 1)
+	go func() {
+		defer close(out)
+
+		var timer *time.Timer
+		var expired <-chan time.Time
+		if maxWait > 0 {
+			timer = time.NewTimer(maxWait)
+			timer.Stop()
+			defer timer.Stop()
+
+			expired = timer.C
+		}
+
+		var batch []T
+		flush := func() bool {
+			if timer != nil {
+				timer.Stop() // go 1.23+: no stale expiration after Stop
+			}
+			if len(batch) == 0 {
+				return true
+			}
+			select {
+			case out <- batch:
+				batch = nil
+				return true
+			case <-quit:
+				return false
+			}
+		}
+
+		for {
+			select {
+			case t, ok := <-in:
+				if !ok {
+					flush()
+					return
+				}
+				if len(batch) == 0 && timer != nil {
+					timer.Reset(maxWait)
+				}
+				batch = append(batch, t)
+				if len(batch) == maxSize && !flush() {
+					return
+				}
+			case <-expired:
+				if !flush() {
+					return
+				}
+			case <-quit:
+				return
+			}
+		}
+	}()
+
+	return out
+}
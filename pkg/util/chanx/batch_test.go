@@ -0,0 +1,63 @@
+package chanx_test
+
+import (
+	"github.com/seekerror/stdlib/pkg/util/chanx"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestBatch(t *testing.T) {
+	quit := make(chan struct{})
+	defer close(quit)
+
+	// (1) Size-based, with final partial batch on close.
+
+	list := chanx.ToList(chanx.Batch(quit, chanx.NewFixed(1, 2, 3, 4, 5, 6, 7), 3, time.Hour))
+	assert.Equal(t, [][]int{{1, 2, 3}, {4, 5, 6}, {7}}, list)
+
+	list = chanx.ToList(chanx.Batch(quit, chanx.NewFixed(1, 2, 3, 4), 2, 0))
+	assert.Equal(t, [][]int{{1, 2}, {3, 4}}, list)
+
+	list = chanx.ToList(chanx.Batch(quit, chanx.NewFixed[int](), 2, time.Hour))
+	assert.Empty(t, list)
+
+	// (2) Time-based: the timer starts with the first value of each batch.
+
+	in := make(chan int)
+	out := chanx.Batch(quit, in, 100, 20*time.Millisecond)
+
+	start := time.Now()
+	in <- 1
+	in <- 2
+	assert.Equal(t, []int{1, 2}, <-out)
+	assert.GreaterOrEqual(t, time.Since(start), 20*time.Millisecond)
+
+	select {
+	case b := <-out:
+		assert.Failf(t, "unexpected batch", "%v", b)
+	case <-time.After(50 * time.Millisecond):
+		// ok: no empty batches
+	}
+
+	in <- 3
+	assert.Equal(t, []int{3}, <-out)
+
+	in <- 4
+	close(in)
+	assert.Equal(t, []int{4}, <-out)
+	_, ok := <-out
+	assert.False(t, ok)
+}
+
+func TestBatchQuit(t *testing.T) {
+	quit := make(chan struct{})
+
+	in := make(chan int)
+	out := chanx.Batch(quit, in, 10, time.Hour)
+	in <- 1
+	close(quit)
+
+	_, ok := <-out
+	assert.False(t, ok)
+}
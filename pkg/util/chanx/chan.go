@@ -38,6 +38,23 @@ func (i *chanIterator[T]) Next() (T, bool) {
 	return v, ok
 }
 
+// ToStream transforms a chan into a stream. Values are retrieved lazily. Once the value chan is
+// closed, the stream reports the first error received on the error chan, if any. The producer is
+// expected to close the error chan, or send on it, after closing the value chan. The error chan
+// may be nil. The stream does not own the chans and Close is a no-op.
+func ToStream[T any](ch <-chan T, errc <-chan error) lang.Stream[T] {
+	return lang.NewStream(func() (T, bool, error) {
+		if v, ok := <-ch; ok {
+			return v, true, nil
+		}
+		var t T
+		if errc == nil {
+			return t, false, nil
+		}
+		return t, false, <-errc
+	}, nil)
+}
+
 // Map transforms values of a chan. Will leak a go routine if input is not closed.
 func Map[T, U any](ch <-chan T, fn func(t T) U) <-chan U {
 	out := make(chan U, 1)
@@ -68,6 +85,14 @@ func MapIf[T, U any](ch <-chan T, fn func(t T) (U, bool)) <-chan U {
 	return out
 }
 
+// TryMap transforms values of a chan with a fallible function, carrying per-value errors as
+// results. Will leak a go routine if input is not closed.
+func TryMap[T, U any](ch <-chan T, fn func(t T) (U, error)) <-chan lang.Result[U] {
+	return Map(ch, func(t T) lang.Result[U] {
+		return lang.NewResult(fn(t))
+	})
+}
+
 // Breaker adds a quit chan breaker to a chan.
 func Breaker[T any](in <-chan T, quit <-chan struct{}) <-chan T {
 	out := make(chan T, 1)
@@ -0,0 +1,64 @@
+package chanx_test
+
+import (
+	"errors"
+	"github.com/seekerror/stdlib/pkg/lang"
+	"github.com/seekerror/stdlib/pkg/util/chanx"
+	"github.com/stretchr/testify/assert"
+	"strconv"
+	"testing"
+)
+
+func TestToStream(t *testing.T) {
+	boom := errors.New("boom")
+
+	// (1) No error chan
+
+	list, err := lang.ToListErr(chanx.ToStream(chanx.NewFixed(1, 2, 3), nil))
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, list)
+
+	// (2) Error chan closed without error
+
+	errc := make(chan error)
+	close(errc)
+	list, err = lang.ToListErr(chanx.ToStream(chanx.NewFixed(1, 2), errc))
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2}, list)
+
+	// (3) Error reported after the values
+
+	ch := make(chan int)
+	errc = make(chan error, 1)
+	go func() {
+		defer close(ch)
+		ch <- 1
+		ch <- 2
+		errc <- boom
+	}()
+
+	s := chanx.ToStream(ch, errc)
+	list = lang.ToList[int](s)
+	assert.Equal(t, []int{1, 2}, list)
+	assert.ErrorIs(t, s.Err(), boom)
+	assert.NoError(t, s.Close())
+}
+
+func TestTryMap(t *testing.T) {
+	// (1) All successful
+
+	list, err := lang.CollectResults(chanx.ToIterator(chanx.TryMap(chanx.NewFixed("1", "2", "3"), strconv.Atoi)))
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, list)
+
+	// (2) A failing item stops the collection
+
+	it := chanx.ToIterator(chanx.TryMap(chanx.NewFixed("1", "x", "3"), strconv.Atoi))
+	list, err = lang.CollectResults(it)
+	assert.ErrorIs(t, err, strconv.ErrSyntax)
+	assert.Equal(t, []int{1}, list)
+
+	r, ok := it.Next()
+	assert.True(t, ok)
+	assert.Equal(t, 3, r.Unwrap())
+}
@@ -0,0 +1,196 @@
+package chanx
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Policy determines how a slow consumer is handled when its buffer is full.
+type Policy int
+
+const (
+	// Block waits for the consumer, which in turn holds back all other consumers.
+	Block Policy = iota
+	// DropOldest discards the oldest buffered value to make room for the new value.
+	DropOldest
+	// DropNewest discards the new value.
+	DropNewest
+)
+
+func (p Policy) String() string {
+	switch p {
+	case Block:
+		return "block"
+	case DropOldest:
+		return "drop-oldest"
+	case DropNewest:
+		return "drop-newest"
+	default:
+		return fmt.Sprintf("policy(%d)", int(p))
+	}
+}
+
+// Merge combines the values of multiple chans into one. The output chan is closed when all
+// inputs are closed or when quit is closed. The relative order of values from the same input is
+// retained.
+func Merge[T any](quit <-chan struct{}, chs ...<-chan T) <-chan T {
+	out := make(chan T, 1)
+
+	var wg sync.WaitGroup
+	for _, ch := range chs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for {
+				select {
+				case msg, ok := <-ch:
+					if !ok {
+						return
+					}
+					if !send(quit, out, msg, Block) {
+						return
+					}
+				case <-quit:
+					return
+				}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+
+	return out
+}
+
+// Broadcast sends every value of a chan to N chans, each with a buffer of the given size. If a
+// buffer is full, the policy determines whether to wait or drop a value. All outputs are closed
+// when the input is closed or when quit is closed.
+func Broadcast[T any](quit <-chan struct{}, in <-chan T, n, size int, policy Policy) []<-chan T {
+	if n < 1 {
+		panic(fmt.Sprintf("invalid number of outputs: %v", n))
+	}
+	if size < 0 {
+		panic(fmt.Sprintf("invalid buffer size: %v", size))
+	}
+
+	outs := make([]chan T, n)
+	ret := make([]<-chan T, n)
+	for i := range outs {
+		outs[i] = make(chan T, size)
+		ret[i] = outs[i]
+	}
+
+	go func() {
+		defer func() {
+			for _, out := range outs {
+				close(out)
+			}
+		}()
+
+		for {
+			select {
+			case msg, ok := <-in:
+				if !ok {
+					return
+				}
+				for _, out := range outs {
+					if !send(quit, out, msg, policy) {
+						return
+					}
+				}
+			case <-quit:
+				return
+			}
+		}
+	}()
+
+	return ret
+}
+
+// send sends the value according to the policy. Returns false if quit was closed, in which case
+// the value is not sent, unless quit is closed concurrently with a blocking send.
+func send[T any](quit <-chan struct{}, out chan T, msg T, policy Policy) bool {
+	select {
+	case <-quit:
+		return false
+	default:
+	}
+
+	switch policy {
+	case DropOldest:
+		select {
+		case out <- msg:
+			return true
+		default:
+		}
+		// Buffer full: discard the oldest value and retry once. If the consumer raced us or
+		// the chan is unbuffered, the new value is dropped instead.
+		select {
+		case <-out:
+		default:
+		}
+		select {
+		case out <- msg:
+		default:
+		}
+		return true
+
+	case DropNewest:
+		select {
+		case out <- msg:
+		default:
+		}
+		return true
+
+	default:
+		select {
+		case out <- msg:
+			return true
+		case <-quit:
+			return false
+		}
+	}
+}
+
+// RoundRobin distributes the values of a chan to N chans in turn, such as for sharding work. It
+// waits for each output in turn. All outputs are closed when the input is closed or when quit is
+// closed.
+func RoundRobin[T any](quit <-chan struct{}, in <-chan T, n int) []<-chan T {
+	if n < 1 {
+		panic(fmt.Sprintf("invalid number of outputs: %v", n))
+	}
+
+	outs := make([]chan T, n)
+	ret := make([]<-chan T, n)
+	for i := range outs {
+		outs[i] = make(chan T, 1)
+		ret[i] = outs[i]
+	}
+
+	go func() {
+		defer func() {
+			for _, out := range outs {
+				close(out)
+			}
+		}()
+
+		for i := 0; ; i = (i + 1) % n {
+			select {
+			case msg, ok := <-in:
+				if !ok {
+					return
+				}
+				if !send(quit, outs[i], msg, Block) {
+					return
+				}
+			case <-quit:
+				return
+			}
+		}
+	}()
+
+	return ret
+}
@@ -0,0 +1,105 @@
+package chanx_test
+
+import (
+	"github.com/seekerror/stdlib/pkg/util/chanx"
+	"github.com/stretchr/testify/assert"
+	"sort"
+	"testing"
+)
+
+func TestMerge(t *testing.T) {
+	quit := make(chan struct{})
+
+	list := chanx.ToList(chanx.Merge(quit, chanx.NewFixed(1, 2, 3), chanx.NewFixed[int](), chanx.NewFixed(4, 5)))
+	sort.Ints(list)
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, list)
+
+	// Quit closes the output, even if inputs are open.
+
+	in := make(chan int)
+	out := chanx.Merge(quit, in)
+	close(quit)
+	_, ok := <-out
+	assert.False(t, ok)
+}
+
+func TestBroadcast(t *testing.T) {
+	quit := make(chan struct{})
+	defer close(quit)
+
+	// (1) Block delivers all values to all outputs.
+
+	outs := chanx.Broadcast(quit, chanx.NewFixed(1, 2, 3), 2, 3, chanx.Block)
+	assert.Equal(t, []int{1, 2, 3}, chanx.ToList(outs[0]))
+	assert.Equal(t, []int{1, 2, 3}, chanx.ToList(outs[1]))
+
+	// (2) Drop policies keep the newest or oldest values of a slow output. The fast output
+	// is consumed in step, which ensures the slow output has been handled for each value.
+
+	for _, tt := range []struct {
+		policy   chanx.Policy
+		expected []int
+	}{
+		{chanx.DropOldest, []int{4, 5}},
+		{chanx.DropNewest, []int{1, 2}},
+	} {
+		in := make(chan int)
+		outs = chanx.Broadcast(quit, in, 2, 2, tt.policy)
+		for i := 1; i <= 5; i++ {
+			in <- i
+			assert.Equal(t, i, <-outs[1])
+		}
+		close(in)
+		assert.Equal(t, tt.expected, chanx.ToList(outs[0]), tt.policy)
+	}
+}
+
+func TestBroadcastInvalid(t *testing.T) {
+	quit := make(chan struct{})
+	defer close(quit)
+
+	assert.Panics(t, func() { chanx.Broadcast(quit, make(chan int), 0, 1, chanx.Block) })
+	assert.Panics(t, func() { chanx.Broadcast(quit, make(chan int), -1, 1, chanx.Block) })
+	assert.Panics(t, func() { chanx.Broadcast(quit, make(chan int), 1, -1, chanx.Block) })
+	assert.Panics(t, func() { chanx.RoundRobin(quit, make(chan int), 0) })
+}
+
+func TestBroadcastQuit(t *testing.T) {
+	quit := make(chan struct{})
+
+	in := make(chan int)
+	outs := chanx.Broadcast(quit, in, 2, 0, chanx.Block)
+	go func() {
+		in <- 1
+	}()
+	assert.Equal(t, 1, <-outs[0]) // outs[1] is not consumed until quit
+
+	// The value may still be delivered, if the send races with quit.
+
+	close(quit)
+	assert.Subset(t, []int{1}, chanx.ToList(outs[1]))
+}
+
+func TestRoundRobin(t *testing.T) {
+	quit := make(chan struct{})
+	defer close(quit)
+
+	in := make(chan int)
+	outs := chanx.RoundRobin(quit, in, 3)
+	go func() {
+		defer close(in)
+		for i := 0; i < 7; i++ {
+			in <- i
+		}
+	}()
+
+	var lists [3][]int
+	for i := 0; i < 7; i++ {
+		lists[i%3] = append(lists[i%3], <-outs[i%3])
+	}
+	for _, out := range outs {
+		_, ok := <-out
+		assert.False(t, ok)
+	}
+	assert.Equal(t, [3][]int{{0, 3, 6}, {1, 4}, {2, 5}}, lists)
+}
@@ -0,0 +1,219 @@
+package chanx
+
+import (
+	"context"
+	"fmt"
+	"github.com/seekerror/stdlib/pkg/lang"
+	"sync"
+)
+
+// ParallelMap transforms values of an iterator using n goroutines. Results are returned in input
+// order, with at most 2n values in flight or buffered for reordering. The stream fails with the
+// first error returned by fn or the input, in input order, or if the context is cancelled. Close
+// cancels any remaining work, waits for the worker goroutines to exit and closes the input. It
+// must be called if the stream is not exhausted, or the goroutines leak. If the input is blocked,
+// Close does not wait for it and closing it must unblock it.
+func ParallelMap[T, U any](ctx context.Context, it lang.Iterator[T], n int, fn func(ctx context.Context, t T) (U, error)) lang.Stream[U] {
+	return newParallel(ctx, it, n, fn, true)
+}
+
+// ParallelMapUnordered transforms values of an iterator using n goroutines. Results are returned
+// in completion order, with at most 2n values in flight. The stream fails with the first error
+// returned. Otherwise, it behaves as ParallelMap.
+func ParallelMapUnordered[T, U any](ctx context.Context, it lang.Iterator[T], n int, fn func(ctx context.Context, t T) (U, error)) lang.Stream[U] {
+	return newParallel(ctx, it, n, fn, false)
+}
+
+type job[T any] struct {
+	seq int
+	t   T
+}
+
+type result[U any] struct {
+	seq int
+	u   U
+	err error
+}
+
+type parallel[T, U any] struct {
+	ctx     context.Context
+	wctx    context.Context
+	cancel  context.CancelFunc
+	in      lang.Iterator[T]
+	results chan result[U]
+	tokens  chan struct{} // bounds the number of values in flight
+	ordered bool
+	workers sync.WaitGroup
+
+	pending map[int]result[U] // out-of-order results and errors, if ordered
+	seq     int               // next result seq, if ordered
+	err     error
+	done    bool
+	closed  bool
+}
+
+func newParallel[T, U any](ctx context.Context, it lang.Iterator[T], n int, fn func(ctx context.Context, t T) (U, error), ordered bool) *parallel[T, U] {
+	if n < 1 {
+		panic(fmt.Sprintf("invalid number of goroutines: %v", n))
+	}
+
+	wctx, cancel := context.WithCancel(ctx)
+	p := &parallel[T, U]{
+		ctx:     ctx,
+		wctx:    wctx,
+		cancel:  cancel,
+		in:      it,
+		results: make(chan result[U], n),
+		tokens:  make(chan struct{}, 2*n),
+		ordered: ordered,
+		pending: map[int]result[U]{},
+	}
+
+	jobs := make(chan job[T])
+	producer := make(chan struct{})
+
+	go func() {
+		defer close(producer)
+		defer close(jobs)
+
+		for seq := 0; ; seq++ {
+			select {
+			case p.tokens <- struct{}{}:
+			case <-wctx.Done():
+				return
+			}
+			if wctx.Err() != nil {
+				return
+			}
+
+			t, ok := it.Next()
+			if !ok {
+				if err := lang.AsStream(it).Err(); err != nil {
+					select {
+					case p.results <- result[U]{seq: seq, err: err}:
+					case <-wctx.Done():
+					}
+				}
+				return
+			}
+
+			select {
+			case jobs <- job[T]{seq: seq, t: t}:
+			case <-wctx.Done():
+				return
+			}
+		}
+	}()
+
+	for i := 0; i < n; i++ {
+		p.workers.Add(1)
+		go func() {
+			defer p.workers.Done()
+
+			for {
+				var j job[T]
+				select {
+				case next, ok := <-jobs:
+					if !ok {
+						return
+					}
+					j = next
+				case <-wctx.Done():
+					return
+				}
+
+				u, err := fn(wctx, j.t)
+				select {
+				case p.results <- result[U]{seq: j.seq, u: u, err: err}:
+				case <-wctx.Done():
+					return
+				}
+			}
+		}()
+	}
+
+	go func() {
+		<-producer
+		p.workers.Wait()
+		close(p.results)
+	}()
+
+	return p
+}
+
+func (p *parallel[T, U]) Next() (U, bool) {
+	for !p.done {
+		var r result[U]
+		if p.ordered {
+			next, ok := p.pending[p.seq]
+			if !ok {
+				if !p.receive() {
+					break
+				}
+				continue
+			}
+			r = next
+			delete(p.pending, p.seq)
+			p.seq++
+		} else {
+			var ok bool
+			if r, ok = p.receiveNext(); !ok {
+				break
+			}
+		}
+
+		if r.err != nil {
+			p.done = true
+			p.err = r.err
+			p.cancel()
+			break
+		}
+		<-p.tokens
+		return r.u, true
+	}
+
+	var u U
+	return u, false
+}
+
+// receive adds the next result to the pending results. Returns false if done.
+func (p *parallel[T, U]) receive() bool {
+	r, ok := p.receiveNext()
+	if ok {
+		p.pending[r.seq] = r
+	}
+	return ok
+}
+
+// receiveNext returns the next result in completion order. Returns false if done.
+func (p *parallel[T, U]) receiveNext() (result[U], bool) {
+	select {
+	case r, ok := <-p.results:
+		if ok {
+			return r, true
+		}
+	case <-p.wctx.Done():
+	}
+	p.done = true
+	p.err = p.ctx.Err()
+	return result[U]{}, false
+}
+
+func (p *parallel[T, U]) Err() error {
+	return p.err
+}
+
+func (p *parallel[T, U]) Close() error {
+	if p.closed {
+		return nil
+	}
+	p.closed = true
+	p.done = true
+	p.cancel()
+
+	// The workers exit promptly once cancelled, but the producer may be blocked on the input.
+	// Closing the input unblocks it, if supported.
+
+	p.workers.Wait()
+	return lang.AsStream(p.in).Close()
+}
@@ -0,0 +1,137 @@
+package chanx_test
+
+import (
+	"context"
+	"errors"
+	"github.com/seekerror/stdlib/pkg/lang"
+	"github.com/seekerror/stdlib/pkg/util/chanx"
+	"github.com/seekerror/stdlib/pkg/util/mathx"
+	"github.com/stretchr/testify/assert"
+	"math/rand"
+	"sort"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func square(ctx context.Context, n int) (int, error) {
+	time.Sleep(time.Duration(rand.Intn(100)) * time.Microsecond)
+	return n * n, nil
+}
+
+func TestParallelMap(t *testing.T) {
+	const N = 200
+
+	var expected []int
+	for i := 0; i < N; i++ {
+		expected = append(expected, i*i)
+	}
+
+	// (1) Ordered
+
+	list, err := lang.ToListErr[int](chanx.ParallelMap(context.Background(), lang.Head(mathx.Numbers(0), N), 8, square))
+	assert.NoError(t, err)
+	assert.Equal(t, expected, list)
+
+	// (2) Unordered
+
+	list, err = lang.ToListErr[int](chanx.ParallelMapUnordered(context.Background(), lang.Head(mathx.Numbers(0), N), 8, square))
+	assert.NoError(t, err)
+	sort.Ints(list)
+	assert.Equal(t, expected, list)
+}
+
+func TestParallelMapError(t *testing.T) {
+	boom := errors.New("boom")
+
+	var calls atomic.Int32
+	s := chanx.ParallelMap(context.Background(), mathx.Numbers(0), 4, func(ctx context.Context, n int) (int, error) {
+		calls.Add(1)
+		if n == 10 {
+			return 0, boom
+		}
+		return n, nil
+	})
+	list, err := lang.ToListErr[int](s)
+	assert.ErrorIs(t, err, boom)
+	assert.LessOrEqual(t, len(list), 10)
+	assert.Less(t, int(calls.Load()), 10+2*4+1)
+}
+
+func TestParallelMapCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	s := chanx.ParallelMapUnordered(ctx, mathx.Numbers(0), 4, square)
+	for i := 0; i < 10; i++ {
+		_, ok := s.Next()
+		assert.True(t, ok)
+	}
+	cancel()
+	for {
+		if _, ok := s.Next(); !ok {
+			break
+		}
+	}
+	assert.ErrorIs(t, s.Err(), context.Canceled)
+	assert.NoError(t, s.Close())
+
+	// Close without exhausting
+
+	s = chanx.ParallelMap(context.Background(), mathx.Numbers(0), 4, square)
+	s.Next()
+	assert.NoError(t, s.Close())
+	_, ok := s.Next()
+	assert.False(t, ok)
+}
+
+func TestParallelMapInputError(t *testing.T) {
+	boom := errors.New("boom")
+
+	for i := 0; i < 50; i++ {
+		n := 0
+		in := lang.NewStream(func() (int, bool, error) {
+			if n == 10 {
+				return 0, false, boom
+			}
+			n++
+			return n - 1, true, nil
+		}, nil)
+
+		list, err := lang.ToListErr[int](chanx.ParallelMap(context.Background(), in, 4, square))
+		assert.ErrorIs(t, err, boom)
+		assert.Equal(t, []int{0, 1, 4, 9, 16, 25, 36, 49, 64, 81}, list)
+	}
+}
+
+func TestParallelMapBlockedInput(t *testing.T) {
+	for _, fn := range []func(context.Context, lang.Iterator[int], int, func(context.Context, int) (int, error)) lang.Stream[int]{
+		chanx.ParallelMap[int, int], chanx.ParallelMapUnordered[int, int],
+	} {
+		ctx, cancel := context.WithCancel(context.Background())
+
+		ch := make(chan int) // never closed
+		s := fn(ctx, chanx.ToIterator(ch), 4, square)
+		ch <- 1
+		v, ok := s.Next()
+		assert.True(t, ok)
+		assert.Equal(t, 1, v)
+
+		cancel()
+
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			_, ok := s.Next()
+			assert.False(t, ok)
+			assert.ErrorIs(t, s.Err(), context.Canceled)
+			assert.NoError(t, s.Close())
+		}()
+
+		select {
+		case <-done:
+			// ok
+		case <-time.After(5 * time.Second):
+			assert.Fail(t, "Next or Close blocked")
+		}
+	}
+}
@@ -0,0 +1,155 @@
+package chanx
+
+import (
+	"fmt"
+	"github.com/seekerror/stdlib/pkg/util/timex"
+	"time"
+)
+
+// Throttle limits the rate of values of a chan using a token bucket, which refills one token per
+// the given duration and holds at most burst tokens. Values are delayed, not dropped, so the
+// input is held back while waiting. The output is closed when the input is closed or when quit
+// is closed.
+func Throttle[T any](quit <-chan struct{}, in <-chan T, every time.Duration, burst int) <-chan T {
+	return ThrottleWithClock(timex.System(), quit, in, every, burst)
+}
+
+// ThrottleWithClock is Throttle with the given clock.
+func ThrottleWithClock[T any](clock timex.Clock, quit <-chan struct{}, in <-chan T, every time.Duration, burst int) <-chan T {
+	if burst < 1 {
+		panic(fmt.Sprintf("invalid burst: %v", burst))
+	}
+
+	out := make(chan T, 1)
+	go func() {
+		defer close(out)
+
+		// The bucket is tracked as the time at which it will be empty, tat. A value may pass
+		// when tat is within burst-1 tokens of now.
+
+		tolerance := time.Duration(burst-1) * every
+		tat := clock.Now()
+
+		for {
+			select {
+			case msg, ok := <-in:
+				if !ok {
+					return
+				}
+
+				now := clock.Now()
+				if tat.Before(now) {
+					tat = now
+				}
+				if wait := tat.Add(-tolerance).Sub(now); wait > 0 {
+					timer := clock.NewTimer(wait)
+					select {
+					case <-timer.C():
+						// ok
+					case <-quit:
+						timer.Stop()
+						return
+					}
+				}
+				tat = tat.Add(every)
+
+				if !send(quit, out, msg, Block) {
+					return
+				}
+			case <-quit:
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// Debounce emits the latest value of a chan once no value has been received for the given
+// quiet duration. A pending value is emitted when the input is closed. The output is closed
+// when the input is closed or when quit is closed.
+func Debounce[T any](quit <-chan struct{}, in <-chan T, quiet time.Duration) <-chan T {
+	return DebounceWithClock(timex.System(), quit, in, quiet)
+}
+
+// DebounceWithClock is Debounce with the given clock.
+func DebounceWithClock[T any](clock timex.Clock, quit <-chan struct{}, in <-chan T, quiet time.Duration) <-chan T {
+	out := make(chan T, 1)
+	go func() {
+		defer close(out)
+
+		timer := clock.NewTimer(quiet)
+		timer.Stop()
+		defer timer.Stop()
+
+		var latest T
+		pending := false
+		for {
+			select {
+			case msg, ok := <-in:
+				if !ok {
+					if pending {
+						send(quit, out, latest, Block)
+					}
+					return
+				}
+				latest, pending = msg, true
+				timer.Reset(quiet)
+			case <-timer.C():
+				if !send(quit, out, latest, Block) {
+					return
+				}
+				pending = false
+			case <-quit:
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// Sample emits the latest value of a chan once per interval, if a value has been received since
+// the last emitted value. A pending value is emitted when the input is closed. The output is
+// closed when the input is closed or when quit is closed.
+func Sample[T any](quit <-chan struct{}, in <-chan T, interval time.Duration) <-chan T {
+	return SampleWithClock(timex.System(), quit, in, interval)
+}
+
+// SampleWithClock is Sample with the given clock.
+func SampleWithClock[T any](clock timex.Clock, quit <-chan struct{}, in <-chan T, interval time.Duration) <-chan T {
+	out := make(chan T, 1)
+	go func() {
+		defer close(out)
+
+		ticker := clock.NewTicker(interval)
+		defer ticker.Stop()
+
+		var latest T
+		pending := false
+		for {
+			select {
+			case msg, ok := <-in:
+				if !ok {
+					if pending {
+						send(quit, out, latest, Block)
+					}
+					return
+				}
+				latest, pending = msg, true
+			case <-ticker.C():
+				if !pending {
+					break
+				}
+				if !send(quit, out, latest, Block) {
+					return
+				}
+				pending = false
+			case <-quit:
+				return
+			}
+		}
+	}()
+
+	return out
+}
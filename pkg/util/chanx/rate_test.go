@@ -0,0 +1,165 @@
+package chanx_test
+
+import (
+	"github.com/seekerror/stdlib/pkg/util/chanx"
+	"github.com/seekerror/stdlib/pkg/util/timex"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+var epoch = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func TestThrottle(t *testing.T) {
+	quit := make(chan struct{})
+	defer close(quit)
+
+	c := timex.NewFake(epoch)
+	in := make(chan int)
+	out := chanx.ThrottleWithClock(c, quit, in, time.Second, 3)
+
+	// (1) A full bucket lets a burst through.
+
+	for i := 0; i < 3; i++ {
+		in <- i
+		assertNext(t, i, out)
+	}
+
+	// (2) An empty bucket refills one token per period.
+
+	for i := 3; i < 5; i++ {
+		in <- i
+		c.BlockUntil(1)
+		assertNone(t, out)
+
+		c.Advance(time.Second)
+		assertNext(t, i, out)
+	}
+
+	// (3) A refilled bucket is capped at the burst size.
+
+	c.Advance(time.Minute)
+	for i := 5; i < 8; i++ {
+		in <- i
+		assertNext(t, i, out)
+	}
+	in <- 8
+	c.BlockUntil(1)
+	assertNone(t, out)
+
+	c.Advance(time.Second)
+	assertNext(t, 8, out)
+
+	close(in)
+	assertClosed(t, out)
+}
+
+func TestDebounce(t *testing.T) {
+	quit := make(chan struct{})
+	defer close(quit)
+
+	c := timex.NewFake(epoch)
+	in := make(chan int)
+	out := chanx.DebounceWithClock(c, quit, in, time.Second)
+
+	in <- 1
+	c.BlockUntil(1)
+	c.Advance(time.Second - time.Millisecond)
+	assertNone(t, out)
+
+	// New values restart the quiet period.
+
+	armed := c.Armed()
+	in <- 2
+	in <- 3
+	c.BlockUntilArmed(armed + 2)
+	c.Advance(time.Millisecond)
+	assertNone(t, out)
+
+	c.Advance(time.Second)
+	assertNext(t, 3, out)
+
+	c.Advance(time.Minute)
+	assertNone(t, out)
+
+	// A pending value is emitted at close.
+
+	in <- 4
+	close(in)
+	assert.Equal(t, []int{4}, chanx.ToList(out))
+}
+
+func TestSample(t *testing.T) {
+	quit := make(chan struct{})
+	defer close(quit)
+
+	c := timex.NewFake(epoch)
+	in := make(chan int)
+	out := chanx.SampleWithClock(c, quit, in, time.Second)
+
+	in <- 1
+	in <- 2
+	c.Advance(time.Second)
+	assertNext(t, 2, out)
+
+	// No new value, no sample.
+
+	c.Advance(time.Second)
+	assertNone(t, out)
+
+	in <- 3
+	c.Advance(time.Second)
+	assertNext(t, 3, out)
+
+	// A pending value is emitted at close.
+
+	in <- 4
+	close(in)
+	assert.Equal(t, []int{4}, chanx.ToList(out))
+}
+
+func TestRateQuit(t *testing.T) {
+	quit := make(chan struct{})
+
+	outs := []<-chan int{
+		chanx.Throttle(quit, make(chan int), time.Hour, 1),
+		chanx.Debounce(quit, make(chan int), time.Hour),
+		chanx.Sample(quit, make(chan int), time.Hour),
+	}
+	close(quit)
+	for _, out := range outs {
+		assertClosed(t, out)
+	}
+}
+
+func assertNext(t *testing.T, expected int, ch <-chan int) {
+	t.Helper()
+
+	select {
+	case v := <-ch:
+		assert.Equal(t, expected, v)
+	case <-time.After(5 * time.Second):
+		assert.Failf(t, "no value", "expected %v", expected)
+	}
+}
+
+func assertClosed(t *testing.T, ch <-chan int) {
+	t.Helper()
+
+	select {
+	case v, ok := <-ch:
+		assert.False(t, ok, "unexpected value: %v", v)
+	case <-time.After(5 * time.Second):
+		assert.Fail(t, "not closed")
+	}
+}
+
+func assertNone(t *testing.T, ch <-chan int) {
+	t.Helper()
+
+	select {
+	case v := <-ch:
+		assert.Failf(t, "unexpected value", "%v", v)
+	default:
+	}
+}
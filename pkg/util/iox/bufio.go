@@ -2,6 +2,7 @@ package iox
 
 import (
 	"bufio"
+	"github.com/seekerror/stdlib/pkg/lang"
 	"io"
 )
 
@@ -47,3 +48,20 @@ func (c *bufReader) Read(p []byte) (n int, err error) {
 func (c *bufReader) Close() error {
 	return c.in.Close()
 }
+
+// Lines returns a stream of the lines of the reader, without line endings. Close closes the
+// reader, if an io.Closer.
+func Lines(r io.Reader) lang.Stream[string] {
+	scanner := bufio.NewScanner(r)
+	return lang.NewStream(func() (string, bool, error) {
+		if scanner.Scan() {
+			return scanner.Text(), true, nil
+		}
+		return "", false, scanner.Err()
+	}, func() error {
+		if c, ok := r.(io.Closer); ok {
+			return c.Close()
+		}
+		return nil
+	})
+}
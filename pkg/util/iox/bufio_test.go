@@ -0,0 +1,56 @@
+package iox_test
+
+import (
+	"errors"
+	"github.com/seekerror/stdlib/pkg/lang"
+	"github.com/seekerror/stdlib/pkg/util/iox"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+type reader struct {
+	io.Reader
+	closed int
+}
+
+func (r *reader) Close() error {
+	r.closed++
+	return nil
+}
+
+func TestLines(t *testing.T) {
+	boom := errors.New("boom")
+
+	// (1) Lines without endings, closing the reader
+
+	r := &reader{Reader: strings.NewReader("a\r\nb\n\nc")}
+	list, err := lang.ToListErr(iox.Lines(r))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "", "c"}, list)
+	assert.Equal(t, 1, r.closed)
+
+	// (2) Close without exhausting. Idempotent.
+
+	r = &reader{Reader: strings.NewReader("a\nb\n")}
+	s := iox.Lines(r)
+	line, ok := s.Next()
+	assert.True(t, ok)
+	assert.Equal(t, "a", line)
+	assert.NoError(t, s.Close())
+	assert.NoError(t, s.Close())
+	assert.Equal(t, 1, r.closed)
+
+	_, ok = s.Next()
+	assert.False(t, ok)
+
+	// (3) Read error is reported
+
+	s = iox.Lines(io.MultiReader(strings.NewReader("a\nb\n"), iotest.ErrReader(boom)))
+	list = lang.ToList[string](s)
+	assert.Equal(t, []string{"a", "b"}, list)
+	assert.ErrorIs(t, s.Err(), boom)
+	assert.NoError(t, s.Close())
+}
@@ -1,6 +1,9 @@
 package iox
 
-import "time"
+import (
+	"github.com/seekerror/stdlib/pkg/util/timex"
+	"time"
+)
 
 // Pulse is a simple manual pulse. Signals are deduplicated, if not consumed.
 type Pulse struct {
@@ -27,7 +30,12 @@ func (p *Pulse) Chan() <-chan bool {
 }
 
 func NewTickerPulse(duration time.Duration) *Pulse {
-	return WithTicker(NewPulse(), time.NewTicker(duration).C)
+	return NewTickerPulseWithClock(timex.System(), duration)
+}
+
+// NewTickerPulseWithClock returns a pulse signaled by a ticker of the given clock.
+func NewTickerPulseWithClock(clock timex.Clock, duration time.Duration) *Pulse {
+	return WithTicker(NewPulse(), clock.NewTicker(duration).C())
 }
 
 func WithTicker(pulse *Pulse, ch <-chan time.Time) *Pulse {
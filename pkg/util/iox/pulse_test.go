@@ -0,0 +1,30 @@
+package iox_test
+
+import (
+	"github.com/seekerror/stdlib/pkg/util/iox"
+	"github.com/seekerror/stdlib/pkg/util/timex"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestPulse(t *testing.T) {
+	p := iox.NewPulse()
+	assert.True(t, p.Emit())
+	assert.False(t, p.Emit())
+	assert.True(t, <-p.Chan())
+	assert.True(t, p.Emit())
+}
+
+func TestTickerPulse(t *testing.T) {
+	c := timex.NewFake(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	p := iox.NewTickerPulseWithClock(c, time.Second)
+	c.Advance(time.Second)
+
+	select {
+	case <-p.Chan():
+		// ok
+	case <-time.After(5 * time.Second):
+		assert.Fail(t, "no pulse")
+	}
+}
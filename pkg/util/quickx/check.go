@@ -0,0 +1,86 @@
+package quickx
+
+import (
+	"github.com/seekerror/stdlib/pkg/lang"
+	"testing"
+	"time"
+)
+
+// Config holds the parameters of a property check.
+type Config struct {
+	// Count is the number of random values to check. Default: 100.
+	Count int
+	// MaxSize is the maximum size of generated values. Default: 50.
+	MaxSize int
+	// MaxShrinks is the maximum number of shrinking steps of a failing value. Default: 1000.
+	MaxShrinks int
+	// Seed is the RNG seed. Default: the current time.
+	Seed int64
+}
+
+func (c Config) withDefaults() Config {
+	if c.Count <= 0 {
+		c.Count = 100
+	}
+	if c.MaxSize <= 0 {
+		c.MaxSize = 50
+	}
+	if c.MaxShrinks <= 0 {
+		c.MaxShrinks = 1000
+	}
+	if c.Seed == 0 {
+		c.Seed = time.Now().UnixNano()
+	}
+	return c
+}
+
+// Check checks that the property holds for random values using the default configuration. If not,
+// it reports a failure with a shrunk counterexample and the seed to reproduce it.
+func Check[T any](t testing.TB, gen Gen[T], prop func(t T) bool) {
+	t.Helper()
+	CheckT(t, Config{}, gen, prop)
+}
+
+// CheckT checks that the property holds for random values using the given configuration. If not,
+// it reports a failure with a shrunk counterexample and the seed to reproduce it.
+func CheckT[T any](t testing.TB, cfg Config, gen Gen[T], prop func(t T) bool) {
+	t.Helper()
+
+	cfg = cfg.withDefaults()
+	if v, orig, ok := Falsify(cfg, gen, prop); ok {
+		t.Errorf("property failed for %v (original: %v, seed: %v)", v, orig, cfg.Seed)
+	}
+}
+
+// Falsify searches for a value for which the property does not hold. If found, it returns the
+// shrunk counterexample and the original value. Zero-valued configuration fields use defaults.
+func Falsify[T any](cfg Config, gen Gen[T], prop func(t T) bool) (T, T, bool) {
+	cfg = cfg.withDefaults()
+
+	it := lang.Head(gen.Values(cfg.Seed, cfg.MaxSize), cfg.Count)
+	orig, ok := lang.First(lang.Filter(it, func(t T) bool {
+		return !prop(t)
+	}))
+	if !ok {
+		var t T
+		return t, t, false
+	}
+	return shrink(cfg, gen, prop, orig), orig, true
+}
+
+// shrink greedily replaces the failing value with its first failing shrink candidate, until
+// none fail or the maximum number of steps is reached.
+func shrink[T any](cfg Config, gen Gen[T], prop func(t T) bool, t T) T {
+	for i := 0; i < cfg.MaxShrinks; i++ {
+		it := lang.AsStream(lang.Filter(gen.shrink(t), func(c T) bool {
+			return !prop(c)
+		}))
+		next, ok := it.Next()
+		_ = it.Close()
+		if !ok {
+			break
+		}
+		t = next
+	}
+	return t
+}
@@ -0,0 +1,221 @@
+// Package quickx provides property-based testing: random value generators with shrinking and
+// checks that report a minimal failing value.
+package quickx
+
+import (
+	"github.com/seekerror/stdlib/pkg/lang"
+	"github.com/seekerror/stdlib/pkg/util/mathx"
+	"maps"
+	"math/rand"
+	"reflect"
+)
+
+// Gen is a generator of random T values. Values are generated for a given size, which bounds
+// their length or magnitude, so that testing can start with small values.
+type Gen[T any] struct {
+	// Generate returns a random value of the given size.
+	Generate func(r *rand.Rand, size int) T
+	// Shrink returns simpler candidate values, simplest first. Optional.
+	Shrink func(t T) lang.Iterator[T]
+}
+
+// Values returns an infinite iterator of random values using a seeded RNG. Values grow in size
+// from 0 to maxSize and then stay at maxSize.
+func (g Gen[T]) Values(seed int64, maxSize int) lang.Iterator[T] {
+	r := rand.New(rand.NewSource(seed))
+	size := 0
+	return lang.FromFunc(func() (T, bool) {
+		ret := g.Generate(r, size)
+		if size < maxSize {
+			size++
+		}
+		return ret, true
+	})
+}
+
+// shrink returns the shrink candidates of the value, if supported.
+func (g Gen[T]) shrink(t T) lang.Iterator[T] {
+	if g.Shrink == nil {
+		return lang.Empty[T]()
+	}
+	return g.Shrink(t)
+}
+
+// Const returns a generator of the given value.
+func Const[T any](t T) Gen[T] {
+	return Gen[T]{
+		Generate: func(r *rand.Rand, size int) T {
+			return t
+		},
+	}
+}
+
+// OneOf returns a generator of the given values. Values shrink towards the first value, i.e., to
+// each earlier value in the list, as identified by reflect.DeepEqual.
+func OneOf[T any](list ...T) Gen[T] {
+	ret := Map(Ints(0, len(list)-1), func(i int) T {
+		return list[i]
+	}, nil)
+	ret.Shrink = func(t T) lang.Iterator[T] {
+		return lang.Generate(func(yield func(T) bool) {
+			for _, v := range list {
+				if reflect.DeepEqual(v, t) || !yield(v) {
+					return
+				}
+			}
+		})
+	}
+	return ret
+}
+
+// Map returns a generator of custom values transformed from generated values. If the inverse
+// function is not nil, values shrink through the source generator.
+func Map[T, U any](g Gen[T], fn func(t T) U, inverse func(u U) T) Gen[U] {
+	ret := Gen[U]{
+		Generate: func(r *rand.Rand, size int) U {
+			return fn(g.Generate(r, size))
+		},
+	}
+	if inverse != nil && g.Shrink != nil {
+		ret.Shrink = func(u U) lang.Iterator[U] {
+			return lang.Map(g.Shrink(inverse(u)), fn)
+		}
+	}
+	return ret
+}
+
+// Ints returns a generator of integers in [min;max]. The size bounds the distance from the
+// value closest to 0, towards which values shrink.
+func Ints(min, max int) Gen[int] {
+	target := mathx.Min(mathx.Max(0, min), max)
+	return Gen[int]{
+		Generate: func(r *rand.Rand, size int) int {
+			lo := mathx.Max(min, target-size)
+			hi := mathx.Min(max, target+size)
+			return lo + r.Intn(hi-lo+1)
+		},
+		Shrink: func(n int) lang.Iterator[int] {
+			return lang.Generate(func(yield func(int) bool) {
+				for d := n - target; d != 0; d /= 2 {
+					if !yield(n - d) {
+						return
+					}
+				}
+			})
+		},
+	}
+}
+
+// Runes returns a generator of runes in [min;max]. Values shrink towards min.
+func Runes(min, max rune) Gen[rune] {
+	return Map(Ints(0, int(max-min)), func(n int) rune {
+		return min + rune(n)
+	}, func(r rune) int {
+		return int(r - min)
+	})
+}
+
+// Strings returns a generator of strings of lower-case letters. The size bounds the length.
+// Values shrink towards shorter strings and earlier letters.
+func Strings() Gen[string] {
+	return StringsOf(Runes('a', 'z'))
+}
+
+// StringsOf returns a generator of strings of generated runes. The size bounds the length.
+func StringsOf(g Gen[rune]) Gen[string] {
+	return Map(Slices(g), func(list []rune) string {
+		return string(list)
+	}, func(s string) []rune {
+		return []rune(s)
+	})
+}
+
+// Slices returns a generator of slices of generated elements. The size bounds the length. Values
+// shrink by removing elements and then by shrinking individual elements.
+func Slices[T any](g Gen[T]) Gen[[]T] {
+	return Gen[[]T]{
+		Generate: func(r *rand.Rand, size int) []T {
+			n := r.Intn(size + 1)
+			ret := make([]T, n)
+			for i := range ret {
+				ret[i] = g.Generate(r, size)
+			}
+			return ret
+		},
+		Shrink: func(list []T) lang.Iterator[[]T] {
+			return lang.Generate(func(yield func([]T) bool) {
+				// (1) Remove chunks of decreasing size.
+
+				for k := len(list); k > 0; k /= 2 {
+					for i := 0; i+k <= len(list); i += k {
+						c := make([]T, 0, len(list)-k)
+						c = append(c, list[:i]...)
+						c = append(c, list[i+k:]...)
+						if !yield(c) {
+							return
+						}
+					}
+				}
+
+				// (2) Shrink individual elements.
+
+				for i := range list {
+					it := lang.AsStream(g.shrink(list[i]))
+					for {
+						e, ok := it.Next()
+						if !ok {
+							break
+						}
+						c := append([]T(nil), list...)
+						c[i] = e
+						if !yield(c) {
+							_ = it.Close()
+							return
+						}
+					}
+				}
+			})
+		},
+	}
+}
+
+// Maps returns a generator of maps of generated keys and values. The size bounds the length.
+// Values shrink by removing keys and then by shrinking individual values.
+func Maps[K comparable, V any](kg Gen[K], vg Gen[V]) Gen[map[K]V] {
+	return Gen[map[K]V]{
+		Generate: func(r *rand.Rand, size int) map[K]V {
+			n := r.Intn(size + 1)
+			ret := map[K]V{}
+			for i := 0; i < n; i++ {
+				ret[kg.Generate(r, size)] = vg.Generate(r, size)
+			}
+			return ret
+		},
+		Shrink: func(m map[K]V) lang.Iterator[map[K]V] {
+			return lang.Generate(func(yield func(map[K]V) bool) {
+				for k := range m {
+					c := maps.Clone(m)
+					delete(c, k)
+					if !yield(c) {
+						return
+					}
+				}
+				for k, v := range m {
+					it := lang.AsStream(vg.shrink(v))
+					for {
+						e, ok := it.Next()
+						if !ok {
+							break
+						}
+						c := maps.Clone(m)
+						c[k] = e
+						if !yield(c) {
+							_ = it.Close()
+							return
+						}
+					}
+				}
+			})
+		},
+	}
+}
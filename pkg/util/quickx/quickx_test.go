@@ -0,0 +1,92 @@
+package quickx_test
+
+import (
+	"github.com/seekerror/stdlib/pkg/util/quickx"
+	"github.com/stretchr/testify/assert"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestCheck(t *testing.T) {
+	quickx.Check(t, quickx.Slices(quickx.Ints(-100, 100)), func(list []int) bool {
+		sort.Ints(list)
+		return sort.IntsAreSorted(list)
+	})
+	quickx.Check(t, quickx.Strings(), func(s string) bool {
+		return strings.ToUpper(strings.ToLower(s)) == strings.ToUpper(s)
+	})
+}
+
+func TestFalsify(t *testing.T) {
+	cfg := quickx.Config{Seed: 1}
+
+	// (1) Integers shrink to the boundary
+
+	n, orig, ok := quickx.Falsify(cfg, quickx.Ints(-1000, 1000), func(n int) bool {
+		return n < 17
+	})
+	assert.True(t, ok)
+	assert.Equal(t, 17, n)
+	assert.GreaterOrEqual(t, orig, 17)
+
+	// (2) Slices shrink to a locally minimal counterexample
+
+	list, _, ok := quickx.Falsify(cfg, quickx.Slices(quickx.Ints(0, 100)), func(list []int) bool {
+		sum := 0
+		for _, n := range list {
+			sum += n
+		}
+		return sum < 30 || len(list) < 2
+	})
+	assert.True(t, ok)
+	sum := 0
+	for _, n := range list {
+		sum += n
+	}
+	assert.Equal(t, 30, sum) // locally minimal: no element can be decremented
+
+	// (3) Strings shrink to shortest and earliest letters
+
+	s, _, ok := quickx.Falsify(cfg, quickx.Strings(), func(s string) bool {
+		return !strings.ContainsAny(s, "xyz")
+	})
+	assert.True(t, ok)
+	assert.Equal(t, "x", s)
+
+	// (4) Maps shrink by removing keys
+
+	m, _, ok := quickx.Falsify(cfg, quickx.Maps(quickx.Strings(), quickx.Ints(0, 10)), func(m map[string]int) bool {
+		return len(m) < 3
+	})
+	assert.True(t, ok)
+	assert.Len(t, m, 3)
+
+	// (5) OneOf shrinks towards the first value
+
+	for seed := int64(1); seed <= 10; seed++ {
+		v, _, ok := quickx.Falsify(quickx.Config{Seed: seed}, quickx.OneOf(1, 2, 3, 4, 5), func(n int) bool {
+			return n < 2 || n == 3
+		})
+		assert.True(t, ok)
+		assert.Equal(t, 2, v)
+	}
+
+	// (6) Properties that hold are not falsified
+
+	_, _, ok = quickx.Falsify(cfg, quickx.OneOf("a", "b"), func(s string) bool {
+		return len(s) == 1
+	})
+	assert.False(t, ok)
+}
+
+func TestGenValues(t *testing.T) {
+	a := quickx.Slices(quickx.Ints(0, 9)).Values(42, 10)
+	b := quickx.Slices(quickx.Ints(0, 9)).Values(42, 10)
+	for i := 0; i < 20; i++ {
+		x, _ := a.Next()
+		y, _ := b.Next()
+		assert.Equal(t, x, y)
+		assert.LessOrEqual(t, len(x), 10)
+	}
+}
@@ -1,6 +1,8 @@
 // Package slicex extends "golang.org/x/exp/slices".
 package slicex
 
+import "github.com/seekerror/stdlib/pkg/lang"
+
 // Map transforms the elements of a slice.
 func Map[E, T any](list []E, fn func(E) T) []T {
 	ret := make([]T, len(list))
@@ -20,3 +22,26 @@ func MapIf[E, T any](list []E, fn func(E) (T, bool)) []T {
 	}
 	return ret
 }
+
+// Zip pairs the elements of two slices. The result has the length of the shorter slice.
+func Zip[A, B any](as []A, bs []B) []lang.Pair[A, B] {
+	n := len(as)
+	if len(bs) < n {
+		n = len(bs)
+	}
+	ret := make([]lang.Pair[A, B], n)
+	for i := range ret {
+		ret[i] = lang.NewPair(as[i], bs[i])
+	}
+	return ret
+}
+
+// Unzip splits a slice of pairs into two slices.
+func Unzip[A, B any](list []lang.Pair[A, B]) ([]A, []B) {
+	as := make([]A, len(list))
+	bs := make([]B, len(list))
+	for i, p := range list {
+		as[i], bs[i] = p.A, p.B
+	}
+	return as, bs
+}
@@ -1,6 +1,7 @@
 package slicex_test
 
 import (
+	"github.com/seekerror/stdlib/pkg/lang"
 	"github.com/seekerror/stdlib/pkg/util/slicex"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -14,3 +15,12 @@ func TestMap(t *testing.T) {
 	assert.Equal(t, strs[0], "1")
 	assert.Equal(t, strs[1], "2")
 }
+
+func TestZip(t *testing.T) {
+	pairs := slicex.Zip([]int{1, 2, 3}, []string{"a", "b"})
+	assert.Equal(t, []lang.Pair[int, string]{{A: 1, B: "a"}, {A: 2, B: "b"}}, pairs)
+
+	as, bs := slicex.Unzip(pairs)
+	assert.Equal(t, []int{1, 2}, as)
+	assert.Equal(t, []string{"a", "b"}, bs)
+}
@@ -0,0 +1,90 @@
+// Package timex provides extensions and utilities to the time package, notably an injectable
+// clock for testing time-dependent code.
+package timex
+
+import (
+	"time"
+)
+
+// Clock is a source of time and timers. It allows time to be faked in tests.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+	// NewTimer returns a timer that fires once after the given duration.
+	NewTimer(d time.Duration) Timer
+	// NewTicker returns a ticker that fires repeatedly with the given period.
+	NewTicker(d time.Duration) Ticker
+}
+
+// Timer is a time.Timer abstraction. Stop and Reset guarantee that no stale time is received
+// afterwards, as with time.Timer since go 1.23.
+type Timer interface {
+	// C returns the chan on which the time is delivered.
+	C() <-chan time.Time
+	// Stop prevents the timer from firing. Returns true if the timer was active.
+	Stop() bool
+	// Reset changes the timer to fire after the given duration. Returns true if the timer was
+	// active.
+	Reset(d time.Duration) bool
+}
+
+// Ticker is a time.Ticker abstraction. Ticks are dropped for slow receivers.
+type Ticker interface {
+	// C returns the chan on which the ticks are delivered.
+	C() <-chan time.Time
+	// Stop turns off the ticker.
+	Stop()
+	// Reset stops the ticker and changes its period to the given duration.
+	Reset(d time.Duration)
+}
+
+// System returns the system clock.
+func System() Clock {
+	return system{}
+}
+
+type system struct{}
+
+func (system) Now() time.Time {
+	return time.Now()
+}
+
+func (system) NewTimer(d time.Duration) Timer {
+	return systemTimer{t: time.NewTimer(d)}
+}
+
+func (system) NewTicker(d time.Duration) Ticker {
+	return systemTicker{t: time.NewTicker(d)}
+}
+
+type systemTimer struct {
+	t *time.Timer
+}
+
+func (t systemTimer) C() <-chan time.Time {
+	return t.t.C
+}
+
+func (t systemTimer) Stop() bool {
+	return t.t.Stop()
+}
+
+func (t systemTimer) Reset(d time.Duration) bool {
+	return t.t.Reset(d)
+}
+
+type systemTicker struct {
+	t *time.Ticker
+}
+
+func (t systemTicker) C() <-chan time.Time {
+	return t.t.C
+}
+
+func (t systemTicker) Stop() {
+	t.t.Stop()
+}
+
+func (t systemTicker) Reset(d time.Duration) {
+	t.t.Reset(d)
+}
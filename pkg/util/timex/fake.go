@@ -0,0 +1,197 @@
+package timex
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Fake is a manual clock for testing. Time only moves when advanced, at which point due timers
+// and tickers fire in order. Thread-safe.
+type Fake struct {
+	now     time.Time
+	waiters []*fakeWaiter
+	armed   int
+	mu      sync.Mutex
+	cond    *sync.Cond
+}
+
+// NewFake returns a fake clock starting at the given time.
+func NewFake(now time.Time) *Fake {
+	ret := &Fake{now: now}
+	ret.cond = sync.NewCond(&ret.mu)
+	return ret
+}
+
+func (f *Fake) Now() time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.now
+}
+
+func (f *Fake) NewTimer(d time.Duration) Timer {
+	w := &fakeWaiter{f: f, c: make(chan time.Time, 1)}
+	w.Reset(d)
+	return w
+}
+
+func (f *Fake) NewTicker(d time.Duration) Ticker {
+	if d <= 0 {
+		panic(fmt.Sprintf("non-positive interval for NewTicker: %v", d))
+	}
+	w := &fakeWaiter{f: f, c: make(chan time.Time, 1), period: d}
+	w.Reset(d)
+	return &fakeTicker{w: w}
+}
+
+// Advance moves the time forward by the given duration and fires any timers and tickers that
+// become due, in order. Ticks are dropped if not consumed, as for time.Ticker.
+func (f *Fake) Advance(d time.Duration) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	end := f.now.Add(d)
+	for {
+		sort.SliceStable(f.waiters, func(i, j int) bool {
+			return f.waiters[i].at.Before(f.waiters[j].at)
+		})
+		if len(f.waiters) == 0 || f.waiters[0].at.After(end) {
+			break
+		}
+
+		w := f.waiters[0]
+		f.now = w.at
+		select {
+		case w.c <- w.at:
+		default:
+		}
+		if w.period > 0 {
+			w.at = w.at.Add(w.period)
+		} else {
+			f.remove(w)
+		}
+	}
+	f.now = end
+	f.cond.Broadcast()
+}
+
+// BlockUntil waits until at least N timers or tickers are active. It allows a test to wait for
+// code under test to start waiting, before advancing the time.
+func (f *Fake) BlockUntil(n int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for len(f.waiters) < n {
+		f.cond.Wait()
+	}
+}
+
+// Armed returns the number of times a timer or ticker has been created or reset.
+func (f *Fake) Armed() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.armed
+}
+
+// BlockUntilArmed waits until timers or tickers have been created or reset at least N times in
+// total. Unlike BlockUntil, it allows a test to wait for an active timer to be reset.
+func (f *Fake) BlockUntilArmed(n int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for f.armed < n {
+		f.cond.Wait()
+	}
+}
+
+func (f *Fake) String() string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return fmt.Sprintf("fake{now=%v, waiters=%v}", f.now, len(f.waiters))
+}
+
+// remove removes the waiter, if active. Returns true if active. Caller must hold the lock.
+func (f *Fake) remove(w *fakeWaiter) bool {
+	for i, v := range f.waiters {
+		if v == w {
+			f.waiters = append(f.waiters[:i], f.waiters[i+1:]...)
+			f.cond.Broadcast()
+			return true
+		}
+	}
+	return false
+}
+
+// fakeWaiter is a timer, or ticker if periodic.
+type fakeWaiter struct {
+	f      *Fake
+	c      chan time.Time
+	at     time.Time
+	period time.Duration
+}
+
+func (w *fakeWaiter) C() <-chan time.Time {
+	return w.c
+}
+
+func (w *fakeWaiter) Stop() bool {
+	w.f.mu.Lock()
+	defer w.f.mu.Unlock()
+
+	w.drain()
+	return w.f.remove(w)
+}
+
+func (w *fakeWaiter) Reset(d time.Duration) bool {
+	w.f.mu.Lock()
+	defer w.f.mu.Unlock()
+
+	w.drain()
+	ret := w.f.remove(w)
+
+	w.f.armed++
+	w.f.cond.Broadcast()
+
+	w.at = w.f.now.Add(d)
+	if w.period > 0 {
+		w.period = d
+	}
+	if d <= 0 && w.period == 0 {
+		w.c <- w.at
+		return ret
+	}
+	w.f.waiters = append(w.f.waiters, w)
+	w.f.cond.Broadcast()
+	return ret
+}
+
+// drain discards any stale time. Caller must hold the lock.
+func (w *fakeWaiter) drain() {
+	select {
+	case <-w.c:
+	default:
+	}
+}
+
+type fakeTicker struct {
+	w *fakeWaiter
+}
+
+func (t *fakeTicker) C() <-chan time.Time {
+	return t.w.C()
+}
+
+func (t *fakeTicker) Stop() {
+	t.w.Stop()
+}
+
+func (t *fakeTicker) Reset(d time.Duration) {
+	if d <= 0 {
+		panic(fmt.Sprintf("non-positive interval for Ticker.Reset: %v", d))
+	}
+	t.w.Reset(d)
+}
@@ -0,0 +1,105 @@
+package timex_test
+
+import (
+	"github.com/seekerror/stdlib/pkg/util/timex"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+var epoch = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func TestFake(t *testing.T) {
+	c := timex.NewFake(epoch)
+	assert.Equal(t, epoch, c.Now())
+
+	// (1) Timers fire once when due.
+
+	t1 := c.NewTimer(2 * time.Second)
+	t2 := c.NewTimer(time.Second)
+	c.BlockUntil(2)
+
+	c.Advance(time.Second)
+	assert.Equal(t, epoch.Add(time.Second), <-t2.C())
+	assertEmpty(t, t1.C())
+
+	c.Advance(time.Hour)
+	assert.Equal(t, epoch.Add(2*time.Second), <-t1.C())
+	assert.Equal(t, epoch.Add(time.Hour+time.Second), c.Now())
+
+	// (2) Stop and Reset discard stale values.
+
+	assert.False(t, t1.Stop())
+	assert.False(t, t1.Reset(time.Second))
+	assert.True(t, t1.Reset(time.Minute))
+	c.Advance(time.Second)
+	assertEmpty(t, t1.C())
+
+	c.Advance(time.Minute)
+	assert.False(t, t1.Reset(time.Second))
+	assertEmpty(t, t1.C())
+	assert.True(t, t1.Stop())
+	c.Advance(time.Minute)
+	assertEmpty(t, t1.C())
+}
+
+func TestFakeTicker(t *testing.T) {
+	c := timex.NewFake(epoch)
+
+	tick := c.NewTicker(time.Second)
+	c.Advance(time.Second)
+	assert.Equal(t, epoch.Add(time.Second), <-tick.C())
+
+	// Ticks are dropped if not consumed.
+
+	c.Advance(3 * time.Second)
+	assert.Equal(t, epoch.Add(2*time.Second), <-tick.C())
+	assertEmpty(t, tick.C())
+
+	c.Advance(time.Second)
+	assert.Equal(t, epoch.Add(5*time.Second), <-tick.C())
+
+	tick.Stop()
+	c.Advance(time.Minute)
+	assertEmpty(t, tick.C())
+}
+
+func TestFakeBlockUntil(t *testing.T) {
+	c := timex.NewFake(epoch)
+
+	done := make(chan time.Time)
+	go func() {
+		done <- <-c.NewTimer(time.Second).C()
+	}()
+
+	c.BlockUntil(1)
+	c.Advance(time.Second)
+	assert.Equal(t, epoch.Add(time.Second), <-done)
+}
+
+func TestFakeBlockUntilArmed(t *testing.T) {
+	c := timex.NewFake(epoch)
+	timer := c.NewTimer(time.Second)
+	assert.Equal(t, 1, c.Armed())
+
+	go func() {
+		timer.Reset(time.Minute)
+	}()
+	c.BlockUntilArmed(2)
+	assert.Equal(t, 2, c.Armed())
+
+	c.Advance(time.Second)
+	assertEmpty(t, timer.C())
+	c.Advance(time.Minute)
+	assert.Equal(t, epoch.Add(time.Minute), <-timer.C())
+}
+
+func assertEmpty(t *testing.T, ch <-chan time.Time) {
+	t.Helper()
+
+	select {
+	case v := <-ch:
+		assert.Failf(t, "unexpected value", "%v", v)
+	default:
+	}
+}